@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/helper/pathorcontents"
@@ -44,6 +45,42 @@ type Config struct {
 	Project     string
 	Region      string
 
+	// UserAgentSuffix is appended to the user agent of the requests getting and setting IAM policies.
+	UserAgentSuffix string
+
+	// RedactIamLogs hides the local parts of the email addresses of the members of IAM policies in the debug logs.
+	RedactIamLogs bool
+
+	// IamBindingAuthoritativeOnCreate is the default of `authoritative_on_create` for the IAM binding resources.
+	IamBindingAuthoritativeOnCreate bool
+
+	// IamReadAfterCreateWait is how long a created IAM binding or member that isn't visible yet is read again for.
+	IamReadAfterCreateWait time.Duration
+
+	// IamMaxTotalRetryDuration caps the time spent retrying the read-modify-write of an IAM policy on conflicts.
+	// Zero means no cap other than the maximum backoff.
+	IamMaxTotalRetryDuration time.Duration
+
+	// ReportRedundantIamGrants logs the roles granted to a member on both a folder and a project within it.
+	ReportRedundantIamGrants bool
+	iamGrants                *iamGrantRegistry
+
+	// SkipIamParentCheck skips checking that the resource holding an IAM policy exists when its policy can't be
+	// read, which reports a missing resource as such.
+	SkipIamParentCheck bool
+
+	// DetectDuplicateIamBindings fails the IAM binding resources managing a role already managed by another one
+	// during the apply.
+	DetectDuplicateIamBindings bool
+	iamBindings                *iamBindingRegistry
+
+	// The base paths of the APIs called by the IAM resources, by API, e.g. `alloydb`, overriding their default
+	// endpoints in defaultBasePaths, e.g. to test against an emulator or to use private.googleapis.com.
+	BasePaths map[string]string
+
+	client    *http.Client
+	userAgent string
+
 	clientBilling                *cloudbilling.Service
 	clientCompute                *compute.Service
 	clientComputeBeta            *computeBeta.Service
@@ -124,11 +161,17 @@ func (c *Config) loadAndValidate() error {
 	}
 
 	client.Transport = logging.NewTransport("Google", client.Transport)
+	if c.UserAgentSuffix != "" {
+		client.Transport = &iamUserAgentTransport{suffix: c.UserAgentSuffix, base: client.Transport}
+	}
 
 	versionString := terraform.VersionString()
 	userAgent := fmt.Sprintf(
 		"(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, versionString)
 
+	c.client = client
+	c.userAgent = userAgent
+
 	var err error
 
 	log.Printf("[INFO] Instantiating GCE client...")
@@ -269,9 +312,93 @@ func (c *Config) loadAndValidate() error {
 	}
 	c.clientDataproc.UserAgent = userAgent
 
+	c.setBasePaths()
+
 	return nil
 }
 
+// defaultBasePaths are the default base paths of the APIs called by the IAM resources, by API.
+//
+// Those of the APIs with a client library exclude the version of the API, which the clients append; those of the
+// APIs called through REST include it. The base paths of the APIs only served by regional endpoints contain a
+// `{region}` or `{location}` placeholder, replaced by the region or location of the resource.
+var defaultBasePaths = map[string]string{
+	"resource_manager":         "https://cloudresourcemanager.googleapis.com/",
+	"resource_manager_v2beta1": "https://cloudresourcemanager.googleapis.com/",
+	"iam":                      "https://iam.googleapis.com/",
+	"kms":                      "https://cloudkms.googleapis.com/",
+
+	"alloydb":                "https://alloydb.googleapis.com/v1/",
+	"apigee":                 "https://apigee.googleapis.com/v1/",
+	"apphub":                 "https://apphub.googleapis.com/v1/",
+	"assured_workloads":      "https://{location}-assuredworkloads.googleapis.com/v1/",
+	"batch":                  "https://batch.googleapis.com/v1/",
+	"beyondcorp":             "https://beyondcorp.googleapis.com/v1/",
+	"certificate_manager":    "https://certificatemanager.googleapis.com/v1/",
+	"cloudbuild":             "https://cloudbuild.googleapis.com/v1/",
+	"clouddeploy":            "https://clouddeploy.googleapis.com/v1/",
+	"compute":                "https://www.googleapis.com/compute/v1/",
+	"container_attached":     "https://{location}-gkemulticloud.googleapis.com/v1/",
+	"data_fusion":            "https://datafusion.googleapis.com/v1/",
+	"dataform":               "https://dataform.googleapis.com/v1beta1/",
+	"dataproc_metastore":     "https://metastore.googleapis.com/v1/",
+	"developer_connect":      "https://developerconnect.googleapis.com/v1/",
+	"discovery_engine":       "https://discoveryengine.googleapis.com/v1/",
+	"edgecontainer":          "https://edgecontainer.googleapis.com/v1/",
+	"eventarc":               "https://eventarc.googleapis.com/v1/",
+	"firestore":              "https://firestore.googleapis.com/v1/",
+	"gemini":                 "https://cloudaicompanion.googleapis.com/v1/",
+	"gke_backup":             "https://gkebackup.googleapis.com/v1/",
+	"gke_hub":                "https://gkehub.googleapis.com/v1/",
+	"integration_connectors": "https://connectors.googleapis.com/v1/",
+	"managed_kafka":          "https://managedkafka.googleapis.com/v1/",
+	"network_connectivity":   "https://networkconnectivity.googleapis.com/v1/",
+	"network_security":       "https://networksecurity.googleapis.com/v1/",
+	"oracle_database":        "https://oracledatabase.googleapis.com/v1/",
+	"parallelstore":          "https://parallelstore.googleapis.com/v1/",
+	"pubsub_lite":            "https://pubsublite.googleapis.com/v1/admin/",
+	"redis":                  "https://redis.googleapis.com/v1/",
+	"security_center_v2":     "https://securitycenter.googleapis.com/v2/",
+	"service_directory":      "https://servicedirectory.googleapis.com/v1/",
+	"vertex_ai":              "https://{region}-aiplatform.googleapis.com/v1/",
+	"vmwareengine":           "https://vmwareengine.googleapis.com/v1/",
+	"workstations":           "https://workstations.googleapis.com/v1/",
+}
+
+// basePath returns the base path of api, overridden in BasePaths or the default one.
+func (c *Config) basePath(api string) string {
+	basePath := c.BasePaths[api]
+	if basePath == "" {
+		return defaultBasePaths[api]
+	}
+	// The paths of the methods and resources are resolved relative to the base path, which must end with a
+	// slash not to lose its last segment.
+	if !strings.HasSuffix(basePath, "/") {
+		basePath += "/"
+	}
+	return basePath
+}
+
+// setBasePaths points the clients of the APIs whose base path is overridden at their overridden endpoints.
+func (c *Config) setBasePaths() {
+	for _, o := range []struct {
+		api    string
+		client *string
+	}{
+		{"resource_manager", &c.clientResourceManager.BasePath},
+		{"resource_manager_v2beta1", &c.clientResourceManagerV2Beta1.BasePath},
+		{"iam", &c.clientIAM.BasePath},
+		{"kms", &c.clientKms.BasePath},
+	} {
+		if c.BasePaths[o.api] == "" {
+			continue
+		}
+		basePath := c.basePath(o.api)
+		log.Printf("[INFO] Using base path %s instead of %s", basePath, *o.client)
+		*o.client = basePath
+	}
+}
+
 // accountFile represents the structure of the account file JSON file.
 type accountFile struct {
 	PrivateKeyId string `json:"private_key_id"`
@@ -1,8 +1,17 @@
 package google
 
 import (
+	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
+	"google.golang.org/api/iam/v1"
 )
 
 const testFakeCredentialsPath = "./test-fixtures/fake_account.json"
@@ -48,3 +57,107 @@ func TestConfigLoadAndValidate_accountFileJSONInvalid(t *testing.T) {
 		t.Fatalf("expected error, but got nil")
 	}
 }
+
+func TestConfigLoadAndValidate_basePaths(t *testing.T) {
+	config := Config{
+		Credentials: testFakeCredentialsPath,
+		Project:     "my-gce-project",
+		Region:      "us-central1",
+		BasePaths: map[string]string{
+			"resource_manager": "https://cloudresourcemanager.private.googleapis.com/",
+			"kms":              "http://localhost:8080",
+		},
+	}
+
+	if err := config.loadAndValidate(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if config.clientResourceManager.BasePath != "https://cloudresourcemanager.private.googleapis.com/" {
+		t.Fatalf("Expected the overridden resource manager base path, got %q", config.clientResourceManager.BasePath)
+	}
+	if config.clientKms.BasePath != "http://localhost:8080/" {
+		t.Fatalf("Expected the overridden KMS base path to end with a slash, got %q", config.clientKms.BasePath)
+	}
+	if config.clientIAM.BasePath != "https://iam.googleapis.com/" {
+		t.Fatalf("Expected the default IAM base path, got %q", config.clientIAM.BasePath)
+	}
+}
+
+func TestConfigSetBasePaths_requestUrl(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"etag": "BwV="}`))
+	}))
+	defer server.Close()
+
+	config := &Config{BasePaths: map[string]string{"resource_manager": server.URL + "/emulator"}}
+	config.clientResourceManager, _ = cloudresourcemanager.New(server.Client())
+	config.clientResourceManagerV2Beta1, _ = resourceManagerV2Beta1.New(server.Client())
+	config.clientIAM, _ = iam.New(server.Client())
+	config.clientKms, _ = cloudkms.New(server.Client())
+	config.setBasePaths()
+
+	d := schema.TestResourceDataRaw(t, IamProjectSchema, map[string]interface{}{
+		"project": "my-project",
+	})
+	updater, err := NewProjectIamUpdater(d, config)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if _, err := updater.GetResourceIamPolicy(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	if len(paths) != 1 || paths[0] != "/emulator/v1/projects/my-project:getIamPolicy" {
+		t.Fatalf("Expected the policy to be read from the overridden base path, got requests to %v", paths)
+	}
+}
+
+func TestConfigBasePath_restIamUpdater(t *testing.T) {
+	var urls []string
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		urls = append(urls, req.URL.String())
+		return testResponse(200, `{"etag": "BwV="}`), nil
+	})
+	config.BasePaths = map[string]string{
+		"alloydb":   "https://alloydb.private.googleapis.com/v1",
+		"vertex_ai": "http://localhost:8080/{region}/v1/",
+	}
+
+	d := schema.TestResourceDataRaw(t, IamAlloyDBClusterSchema, map[string]interface{}{
+		"project":  "my-project",
+		"location": "us-central1",
+		"cluster":  "my-cluster",
+	})
+	updater, err := NewAlloyDBClusterIamUpdater(d, config)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if _, err := updater.GetResourceIamPolicy(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	// The placeholders of an overridden base path are replaced like those of the default one.
+	d = schema.TestResourceDataRaw(t, IamVertexAIMetadataStoreSchema, map[string]interface{}{
+		"project":        "my-project",
+		"region":         "europe-west1",
+		"metadata_store": "default",
+	})
+	updater, err = NewVertexAIMetadataStoreIamUpdater(d, config)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if _, err := updater.GetResourceIamPolicy(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	expected := []string{
+		"https://alloydb.private.googleapis.com/v1/projects/my-project/locations/us-central1/clusters/my-cluster:getIamPolicy",
+		"http://localhost:8080/europe-west1/v1/projects/my-project/locations/europe-west1/metadataStores/default:getIamPolicy",
+	}
+	if fmt.Sprint(urls) != fmt.Sprint(expected) {
+		t.Fatalf("Expected the policies to be read from the overridden base paths %v, got requests to %v", expected, urls)
+	}
+}
@@ -1,10 +1,20 @@
 package google
 
 import (
+	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"io/ioutil"
 	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -15,8 +25,8 @@ type ResourceIamUpdater interface {
 	// Fetch the existing IAM policy attached to a resource.
 	GetResourceIamPolicy() (*cloudresourcemanager.Policy, error)
 
-	// Replaces the existing IAM Policy attached to a resource.
-	SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error
+	// Replaces the existing IAM Policy attached to a resource, and returns the policy as applied.
+	SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error)
 
 	// A mutex guards against concurrent to call to the SetResourceIamPolicy method.
 	// The mutex key should be made of the resource type and resource id.
@@ -29,50 +39,557 @@ type ResourceIamUpdater interface {
 	// Textual description of this resource to be used in error message.
 	// The description should include the unique resource identifier.
 	DescribeResource() string
+
+	// Structured description of this resource, to be used by tooling keying on the resource
+	// rather than parsing the output of DescribeResource.
+	GetResourceDescriptor() IamResourceDescriptor
+}
+
+// IamResourceDescriptor identifies the resource holding an IAM policy.
+type IamResourceDescriptor struct {
+	// The type of the resource, named after the IAM resources managing it. For example: `project`.
+	ResourceType string `json:"resource_type"`
+
+	// The unique resource identifier, as returned by GetResourceId.
+	ResourceId string `json:"resource_id"`
+
+	// The name of the resource containing this one, if it is part of the resource identifier.
+	// For example: `projects/{project}/locations/{location}`.
+	Parent string `json:"parent,omitempty"`
+}
+
+// String formats the descriptor as key=value pairs, the format used for it in logs.
+func (d IamResourceDescriptor) String() string {
+	return fmt.Sprintf("resource_type=%s resource_id=%s parent=%s", d.ResourceType, d.ResourceId, d.Parent)
+}
+
+// newRestIamResourceDescriptor describes a resource whose identifier is a name of the form
+// `{parent}/{collection}/{name}`, as used by the REST backed updaters.
+func newRestIamResourceDescriptor(resourceType, resourceId string) IamResourceDescriptor {
+	parent := ""
+	if parts := strings.Split(resourceId, "/"); len(parts) > 2 {
+		parent = strings.Join(parts[:len(parts)-2], "/")
+	}
+
+	return IamResourceDescriptor{
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+		Parent:       parent,
+	}
+}
+
+// The ResourceIamParentChecker interface can optionally be implemented by a ResourceIamUpdater
+// to verify that the resource holding the IAM policy exists when its policy can't be read or written.
+//
+// The check should be cheap, e.g. a get restricted to a single field of the resource.
+type ResourceIamParentChecker interface {
+	// Returns an error if the resource doesn't exist or isn't accessible by the caller.
+	CheckParentExists() error
 }
 
 type newResourceIamUpdaterFunc func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error)
+
+// A resourceIdParserFunc sets the parent specific fields of an imported IAM resource from its ID.
+type resourceIdParserFunc func(d *schema.ResourceData, config *Config) error
+
 type iamPolicyModifyFunc func(p *cloudresourcemanager.Policy) error
 
-func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
+// iamPolicyReadModifyWrite applies modify to the IAM policy of the resource, and returns the policy
+// as applied. It returns a nil policy if modify left the policy unchanged, in which case it isn't written.
+func iamPolicyReadModifyWrite(config *Config, updater ResourceIamUpdater, modify iamPolicyModifyFunc) (*cloudresourcemanager.Policy, error) {
 	mutexKey := updater.GetMutexKey()
 	mutexKV.Lock(mutexKey)
 	defer mutexKV.Unlock(mutexKey)
 
+	var applied *cloudresourcemanager.Policy
+	start := time.Now()
+	backoff := time.Second
 	for {
-		backoff := time.Second
-		log.Printf("[DEBUG]: Retrieving policy for %s\n", updater.DescribeResource())
+		log.Printf("[DEBUG]: Retrieving policy for %s (%s)\n", updater.DescribeResource(), updater.GetResourceDescriptor())
 		p, err := updater.GetResourceIamPolicy()
 		if err != nil {
-			return err
+			return nil, newIamError(checkIamParentOnError(config, updater, err))
+		}
+		log.Printf("[DEBUG]: Retrieved policy for %s (%s): %s\n", updater.DescribeResource(), updater.GetResourceDescriptor(), formatIamPolicyForLog(config, p))
+
+		existing := &cloudresourcemanager.Policy{}
+		if err := Convert(p, existing); err != nil {
+			return nil, err
 		}
-		log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
 
 		err = modify(p)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		if iamPoliciesEqual(existing, p) {
+			log.Printf("[DEBUG]: Policy for %s (%s) is already up to date, not setting it\n", updater.DescribeResource(), updater.GetResourceDescriptor())
+			return nil, nil
 		}
 
-		log.Printf("[DEBUG]: Setting policy for %s to %+v\n", updater.DescribeResource(), p)
-		err = updater.SetResourceIamPolicy(p)
+		log.Printf("[DEBUG]: Setting policy for %s (%s) to %s\n", updater.DescribeResource(), updater.GetResourceDescriptor(), formatIamPolicyForLog(config, p))
+		applied, err = updater.SetResourceIamPolicy(p)
 		if err == nil {
 			break
 		}
 		if isConflictError(err) {
+			if max := config.IamMaxTotalRetryDuration; max > 0 && time.Since(start)+backoff > max {
+				return nil, fmt.Errorf("Error applying IAM policy to %s: IAM policy contention exceeded max retry duration of %s.\n", updater.DescribeResource(), max)
+			}
 			log.Printf("[DEBUG]: Concurrent policy changes, restarting read-modify-write after %s\n", backoff)
 			time.Sleep(backoff)
 			backoff = backoff * 2
 			if backoff > 30*time.Second {
-				return fmt.Errorf("Error applying IAM policy to %s: too many concurrent policy changes.\n", updater.DescribeResource())
+				return nil, fmt.Errorf("Error applying IAM policy to %s: too many concurrent policy changes.\n", updater.DescribeResource())
 			}
 			continue
 		}
-		return fmt.Errorf("Error applying IAM policy for %s: %v", updater.DescribeResource(), err)
+		return nil, newIamError(errwrap.Wrapf(fmt.Sprintf("Error applying IAM policy for %s: {{err}}", updater.DescribeResource()), err))
+	}
+	log.Printf("[DEBUG]: Set policy for %s (%s)", updater.DescribeResource(), updater.GetResourceDescriptor())
+	return applied, nil
+}
+
+// formatIamPolicyForLog formats the bindings of p for the debug logs. When `redact_iam_logs` is set, the
+// local parts of the email addresses of the members are replaced by a placeholder.
+func formatIamPolicyForLog(config *Config, p *cloudresourcemanager.Policy) string {
+	bindings := make([]string, 0, len(p.Bindings))
+	for _, b := range p.Bindings {
+		members := make([]string, 0, len(b.Members))
+		for _, m := range b.Members {
+			members = append(members, logIamMember(config, m))
+		}
+		bindings = append(bindings, fmt.Sprintf("%s: [%s]", b.Role, strings.Join(members, ", ")))
+	}
+	return fmt.Sprintf("etag=%q bindings=[%s]", p.Etag, strings.Join(bindings, "; "))
+}
+
+// logIamMember returns member as it should be logged, i.e. redacted when `redact_iam_logs` is set.
+func logIamMember(config *Config, member string) string {
+	if config.RedactIamLogs {
+		return redactIamMember(member)
+	}
+	return member
+}
+
+// redactIamMember replaces the local part of the email address of member, if any, by `***`. For example,
+// `user:jane@example.com` is redacted as `user:***@example.com`.
+func redactIamMember(member string) string {
+	at := strings.LastIndex(member, "@")
+	if at < 0 {
+		return member
+	}
+	start := strings.LastIndex(member[:at], ":") + 1
+	return member[:start] + "***" + member[at:]
+}
+
+// iamPoliciesEqual returns whether a and b grant the same roles to the same members and have the
+// same audit configs, regardless of the order of their bindings and members.
+func iamPoliciesEqual(a, b *cloudresourcemanager.Policy) bool {
+	if !reflect.DeepEqual(rolesToMembersMap(a.Bindings), rolesToMembersMap(b.Bindings)) {
+		return false
+	}
+
+	if len(a.AuditConfigs) == 0 && len(b.AuditConfigs) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a.AuditConfigs, b.AuditConfigs)
+}
+
+// setLastAppliedIamPolicy records the policy applied by a write in the state of the resource.
+// A nil policy means that nothing was written, in which case the attributes are left as they are.
+func setLastAppliedIamPolicy(d *schema.ResourceData, p *cloudresourcemanager.Policy) {
+	if p == nil {
+		return
+	}
+
+	d.Set("last_applied_etag", p.Etag)
+	d.Set("last_applied_time", time.Now().UTC().Format(time.RFC3339))
+}
+
+// exportIamPolicy writes the policy applied by a write to the local JSON file set in `export_policy_to`, if any, as
+// an audit record. The file is replaced atomically, by renaming a temporary file written next to it. As the policy
+// was applied already, failing to write the file is only logged.
+func exportIamPolicy(d *schema.ResourceData, updater ResourceIamUpdater, p *cloudresourcemanager.Policy) {
+	path := d.Get("export_policy_to").(string)
+	if p == nil || path == "" {
+		return
+	}
+
+	if err := writeFileAtomically(path, p); err != nil {
+		log.Printf("[WARN] Couldn't export the IAM policy for %s to %q: %s", updater.DescribeResource(), path, err)
+	}
+}
+
+func writeFileAtomically(path string, p *cloudresourcemanager.Policy) error {
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// The interval between the reads of a binding or member not visible yet after its creation.
+const iamReadAfterCreateInterval = 500 * time.Millisecond
+
+// readIamAfterCreate reads a binding or member in the state of d with read after its creation. As IAM policies are
+// eventually consistent, the binding or member may not be visible right away, so it's read again until it appears
+// or `iam_read_after_create_wait` elapses before being removed from state.
+func readIamAfterCreate(d *schema.ResourceData, meta interface{}, read schema.ReadFunc) error {
+	id := d.Id()
+	deadline := time.Now().Add(meta.(*Config).IamReadAfterCreateWait)
+	for {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+		if d.Id() != "" || !time.Now().Before(deadline) {
+			return nil
+		}
+
+		log.Printf("[DEBUG]: %q isn't visible yet after its creation, reading it again in %s\n", id, iamReadAfterCreateInterval)
+		d.SetId(id)
+		time.Sleep(iamReadAfterCreateInterval)
 	}
-	log.Printf("[DEBUG]: Set policy for %s", updater.DescribeResource())
+}
+
+// checkIamParentOnError runs the check of the updater if it implements one, after err was returned while reading
+// the policy of the resource, so that a missing parent is reported as such rather than as a failure to
+// access the policy. It returns the error of the check if the parent isn't found, and err otherwise.
+//
+// The check only runs after a failure, so that a caller allowed to manage the policy but not to get the resource
+// isn't denied, and not at all with `skip_iam_parent_check`.
+func checkIamParentOnError(config *Config, updater ResourceIamUpdater, err error) error {
+	checker, ok := updater.(ResourceIamParentChecker)
+	if !ok || config.SkipIamParentCheck || !isGoogleApiErrorWithCode(err, 403, 404) {
+		return err
+	}
+
+	log.Printf("[DEBUG]: Checking that %s exists\n", updater.DescribeResource())
+	if perr := checker.CheckParentExists(); isGoogleApiErrorWithCode(perr, 404) {
+		return perr
+	}
+
+	return err
+}
+
+// iamParentError converts the error returned while fetching the parent of an IAM policy
+// into an error describing the parent.
+func iamParentError(updater ResourceIamUpdater, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if isGoogleApiErrorWithCode(err, 404) {
+		return errwrap.Wrapf(fmt.Sprintf("%s not found: {{err}}", updater.DescribeResource()), err)
+	}
+
+	return errwrap.Wrapf(fmt.Sprintf("Error checking that %s exists: {{err}}", updater.DescribeResource()), err)
+}
+
+// handleIamParentNotFoundOnDelete returns nil if err is a 404 returned while deleting an IAM resource, and
+// removes the resource from state. Its parent doesn't exist anymore, e.g. as it was destroyed first, so neither
+// does the resource. It returns err otherwise.
+func handleIamParentNotFoundOnDelete(err error, d *schema.ResourceData, updater ResourceIamUpdater) error {
+	if !isGoogleApiErrorWithCode(err, 404) {
+		return err
+	}
+
+	log.Printf("[WARN] %s not found, removing %q from state as it was deleted along with it: %s", updater.DescribeResource(), d.Id(), err)
+	d.SetId("")
 	return nil
 }
 
+// getRestIamPolicy fetches the IAM policy of a resource exposing the standard `getIamPolicy`
+// method through an API for which no client library is vendored.
+// Most APIs expose `getIamPolicy` as a GET, some older ones as a POST.
+func getRestIamPolicy(config *Config, method, resourceUrl string) (*cloudresourcemanager.Policy, error) {
+	return sendIamPolicyRequest(config, method, resourceUrl, resourceUrl+":getIamPolicy", getIamPolicyRequestBody(method))
+}
+
+// setRestIamPolicy replaces the IAM policy of a resource exposing the standard `setIamPolicy`
+// method through an API for which no client library is vendored.
+func setRestIamPolicy(config *Config, resourceUrl string, policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	return sendIamPolicyRequest(config, "POST", resourceUrl, resourceUrl+":setIamPolicy", &cloudresourcemanager.SetIamPolicyRequest{
+		Policy: policy,
+	})
+}
+
+// getComputeRestIamPolicy fetches the IAM policy of a compute resource for which the vendored compute
+// client has no IAM methods. The compute API exposes them as sub-resources rather than custom methods.
+func getComputeRestIamPolicy(config *Config, resourceUrl string) (*cloudresourcemanager.Policy, error) {
+	return sendIamPolicyRequest(config, "GET", resourceUrl, resourceUrl+"/getIamPolicy", nil)
+}
+
+// setComputeRestIamPolicy replaces the IAM policy of a compute resource for which the vendored compute
+// client has no IAM methods.
+func setComputeRestIamPolicy(config *Config, resourceUrl string, policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	return sendIamPolicyRequest(config, "POST", resourceUrl, resourceUrl+"/setIamPolicy", &cloudresourcemanager.SetIamPolicyRequest{
+		Policy: policy,
+	})
+}
+
+func getIamPolicyRequestBody(method string) interface{} {
+	if method == "POST" {
+		return &cloudresourcemanager.GetIamPolicyRequest{}
+	}
+	return nil
+}
+
+// sendIamPolicyRequest sends a request getting or setting the IAM policy of the resource at resourceUrl. The
+// fields of its audit configs unknown to the vendored Policy type are kept from the policies returned, and
+// added back to those set.
+func sendIamPolicyRequest(config *Config, method, resourceUrl, url string, body interface{}) (*cloudresourcemanager.Policy, error) {
+	var raw json.RawMessage
+	if err := sendRequest(config, method, url, iamAuditConfigFields.restore(resourceUrl, body), &raw); err != nil {
+		return nil, err
+	}
+
+	p := &cloudresourcemanager.Policy{}
+	if err := json.Unmarshal(raw, p); err != nil {
+		return nil, err
+	}
+	iamAuditConfigFields.keep(resourceUrl, raw)
+
+	return p, nil
+}
+
+// isIamUnsupportedError returns whether err was returned by an API which doesn't implement the IAM
+// methods for a resource. Such APIs answer with a 404 for the `getIamPolicy` method of an existing
+// resource, or with a 501.
+func isIamUnsupportedError(err error) bool {
+	return isGoogleApiErrorWithCode(err, 404, 501)
+}
+
+// parseIamImportId sets the fields of an imported IAM resource from its ID, which must match format.
+// A `{field}` placeholder of format matches a single segment of the ID.
+func parseIamImportId(d *schema.ResourceData, format string) error {
+	formatParts := strings.Split(format, "/")
+	idParts := strings.Split(d.Id(), "/")
+	if len(formatParts) != len(idParts) {
+		return fmt.Errorf("Import id %q doesn't match the expected format %q", d.Id(), format)
+	}
+
+	fields := make(map[string]string)
+	for i, part := range formatParts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") && idParts[i] != "" {
+			fields[part[1:len(part)-1]] = idParts[i]
+			continue
+		}
+		if part != idParts[i] {
+			return fmt.Errorf("Import id %q doesn't match the expected format %q", d.Id(), format)
+		}
+	}
+
+	for field, value := range fields {
+		if err := d.Set(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Google prefixes the members of a binding whose principal was deleted with `deleted:`, and suffixes
+// them with the unique ID of the principal. For example: `deleted:serviceAccount:foo@example.com?uid=123`.
+const iamDeletedMemberPrefix = "deleted:"
+
+// The types of the identities a role can be granted to, i.e. the prefixes of the members.
+var iamMemberTypes = []string{"user", "serviceAccount", "group", "domain", "projectOwner", "projectEditor", "projectViewer", "principal", "principalSet"}
+
+// The principals and principal sets are identified by the name of a resource of a service, e.g.
+// `principalSet://cloudresourcemanager.googleapis.com/projects/123/type/ServiceAccount` for the service accounts of
+// a project. The names are case sensitive, and are compared and stored as they are.
+var (
+	iamPrincipalRegexp          = regexp.MustCompile(`^//[a-z0-9-]+(\.[a-z0-9-]+)*\.googleapis\.com/[^/]+(/[^/]+)*$`)
+	iamHierarchyPrincipalRegexp = regexp.MustCompile(`^//cloudresourcemanager\.googleapis\.com/(projects|folders|organizations)/[^/]+/type/[A-Za-z]+$`)
+)
+
+// parseIamDeletedMember returns the member a deleted member was granted the role as, and whether
+// member is a deleted member.
+func parseIamDeletedMember(member string) (string, bool) {
+	if !strings.HasPrefix(member, iamDeletedMemberPrefix) {
+		return member, false
+	}
+
+	original := strings.TrimPrefix(member, iamDeletedMemberPrefix)
+	if i := strings.Index(original, "?uid="); i >= 0 {
+		original = original[:i]
+	}
+	return original, true
+}
+
+func validateIamMember(i interface{}, k string) (s []string, es []error) {
+	member := i.(string)
+	if member == "allUsers" || member == "allAuthenticatedUsers" {
+		return
+	}
+
+	original, _ := parseIamDeletedMember(member)
+	parts := strings.SplitN(original, ":", 2)
+	if len(parts) == 2 && parts[1] != "" {
+		for _, t := range iamMemberTypes {
+			if parts[0] == t && (!strings.HasPrefix(t, "principal") || isValidIamPrincipal(parts[1])) {
+				return
+			}
+		}
+	}
+
+	es = append(es, fmt.Errorf("%q: %q isn't a valid member, expected allUsers, allAuthenticatedUsers or {type}:{id} with a type among %s, optionally prefixed by %q",
+		k, member, strings.Join(iamMemberTypes, ", "), iamDeletedMemberPrefix))
+	return
+}
+
+// validateIamMemberPattern validates a pattern of `system_managed_members`, in which `*` matches any sequence of
+// characters but `/`, and `?` any single character but `/`.
+func validateIamMemberPattern(i interface{}, k string) (s []string, es []error) {
+	pattern := i.(string)
+	if _, err := path.Match(pattern, ""); err != nil {
+		es = append(es, fmt.Errorf("%q: %q isn't a valid member pattern: %s", k, pattern, err))
+	}
+	return
+}
+
+// getSystemManagedIamMemberPatterns returns the patterns of `system_managed_members`, matching the members granted
+// roles by Google itself, e.g. service agents, which are added back when removed.
+func getSystemManagedIamMemberPatterns(d *schema.ResourceData) []string {
+	if v, ok := d.GetOk("system_managed_members"); ok {
+		return convertStringSet(v.(*schema.Set))
+	}
+	return nil
+}
+
+func isSystemManagedIamMember(member string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, member); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getSystemManagedIamMembers returns the members granted role in p which match patterns.
+func getSystemManagedIamMembers(p *cloudresourcemanager.Policy, role string, patterns []string) []string {
+	var members []string
+	for _, b := range p.Bindings {
+		if b.Role != role {
+			continue
+		}
+		for _, m := range b.Members {
+			if isSystemManagedIamMember(m, patterns) {
+				members = append(members, m)
+			}
+		}
+	}
+	return members
+}
+
+// isValidIamPrincipal returns whether id identifies a principal or a principal set, i.e. the resource of its
+// service. The principal sets of the resource hierarchy are those of a type of principal of a project, folder or
+// organization.
+func isValidIamPrincipal(id string) bool {
+	if strings.HasPrefix(id, "//cloudresourcemanager.googleapis.com/") {
+		return iamHierarchyPrincipalRegexp.MatchString(id)
+	}
+	return iamPrincipalRegexp.MatchString(id)
+}
+
+// getResourceIamRole returns the role of a binding or member resource. When `expand_custom_role` is set, a role
+// given by the short name of a custom role, e.g. `myCustomRole`, is expanded to the custom role of that name
+// of the project or organization of the resource, e.g. `projects/my-project/roles/myCustomRole`.
+func getResourceIamRole(d *schema.ResourceData, updater ResourceIamUpdater) (string, error) {
+	role := d.Get("role").(string)
+	if canonical := canonicalIamRole(role); canonical != role {
+		if !d.Get("normalize_role_case").(bool) {
+			return "", fmt.Errorf("Role %q isn't spelled as the API expects, it should be %q. Fix its case, or set `normalize_role_case` to correct it automatically.", role, canonical)
+		}
+		log.Printf("[DEBUG]: Normalizing role %q to %q\n", role, canonical)
+		role = canonical
+	}
+
+	if !d.Get("expand_custom_role").(bool) || strings.Contains(role, "/") {
+		return role, nil
+	}
+
+	parent, err := getIamCustomRoleParent(updater)
+	if err != nil {
+		return "", err
+	}
+	return parent + "/roles/" + role, nil
+}
+
+// canonicalIamRole returns role spelled as the API expects: the collections of its name in lower case and,
+// for a predefined role, its service in lower case and its name starting with a lower case letter,
+// e.g. `roles/compute.storageAdmin` for `Roles/Compute.StorageAdmin`. The names of custom roles are left as
+// they are, as they are chosen freely.
+func canonicalIamRole(role string) string {
+	parts := strings.Split(role, "/")
+	switch {
+	case len(parts) == 2 && strings.EqualFold(parts[0], "roles"):
+		parts[0] = "roles"
+		name := strings.SplitN(parts[1], ".", 2)
+		if len(name) == 2 {
+			name[0] = strings.ToLower(name[0])
+		}
+		if last := name[len(name)-1]; last != "" {
+			name[len(name)-1] = strings.ToLower(last[:1]) + last[1:]
+		}
+		parts[1] = strings.Join(name, ".")
+	case len(parts) == 4 && (strings.EqualFold(parts[0], "projects") || strings.EqualFold(parts[0], "organizations")) && strings.EqualFold(parts[2], "roles"):
+		parts[0] = strings.ToLower(parts[0])
+		parts[2] = "roles"
+	}
+	return strings.Join(parts, "/")
+}
+
+// getIamCustomRoleParent returns the project or organization of the resource of updater, whose custom
+// roles can be granted on it.
+func getIamCustomRoleParent(updater ResourceIamUpdater) (string, error) {
+	desc := updater.GetResourceDescriptor()
+	switch desc.ResourceType {
+	case "project":
+		return "projects/" + desc.ResourceId, nil
+	case "organization":
+		return "organizations/" + desc.ResourceId, nil
+	}
+
+	parts := strings.Split(desc.ResourceId, "/")
+	if len(parts) >= 2 && (parts[0] == "projects" || parts[0] == "organizations") {
+		return parts[0] + "/" + parts[1], nil
+	}
+	return "", fmt.Errorf("Custom roles can't be expanded for %s, which belongs to neither a project nor an organization", updater.DescribeResource())
+}
+
+// listResourceIamBindings returns the bindings of the IAM policy of a resource, one per role and
+// sorted by role, e.g. to generate the binding resources adopting an existing policy.
+func listResourceIamBindings(updater ResourceIamUpdater) ([]*cloudresourcemanager.Binding, error) {
+	p, err := updater.GetResourceIamPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := mergeBindings(p.Bindings)
+	for _, b := range bindings {
+		sort.Strings(b.Members)
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		return bindings[i].Role < bindings[j].Role
+	})
+	return bindings, nil
+}
+
 // Merge multiple Bindings such that Bindings with the same Role result in
 // a single Binding with combined Members
 func mergeBindings(bindings []*cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
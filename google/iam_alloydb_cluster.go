@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamAlloyDBClusterSchema = map[string]*schema.Schema{
+	"cluster": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var alloyDBClusterIamResource = GenericIamResource{
+	Type:                "alloydb_cluster",
+	Description:         "AlloyDB cluster",
+	Api:                 "alloydb",
+	PathTemplate:        "projects/{project}/locations/{location}/clusters/{cluster}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewAlloyDBClusterIamUpdater = NewGenericResourceIamUpdater(alloyDBClusterIamResource, IamAlloyDBClusterSchema)
+	AlloyDBClusterIdParseFunc   = genericIamIdParseFunc(alloyDBClusterIamResource)
+)
@@ -0,0 +1,24 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamApigeeOrganizationSchema = map[string]*schema.Schema{
+	"org_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var apigeeOrganizationIamResource = GenericIamResource{
+	Type:                "apigee_organization",
+	Description:         "Apigee organization",
+	Api:                 "apigee",
+	PathTemplate:        "organizations/{org_id}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewApigeeOrganizationIamUpdater = NewGenericResourceIamUpdater(apigeeOrganizationIamResource, IamApigeeOrganizationSchema)
+	ApigeeOrganizationIdParseFunc   = genericIamIdParseFunc(apigeeOrganizationIamResource)
+)
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamAppHubApplicationSchema = map[string]*schema.Schema{
+	"application": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var appHubApplicationIamResource = GenericIamResource{
+	Type:         "apphub_application",
+	Description:  "App Hub application",
+	Api:          "apphub",
+	PathTemplate: "projects/{project}/locations/{location}/applications/{application}",
+}
+
+var (
+	NewAppHubApplicationIamUpdater = NewGenericResourceIamUpdater(appHubApplicationIamResource, IamAppHubApplicationSchema)
+	AppHubApplicationIdParseFunc   = genericIamIdParseFunc(appHubApplicationIamResource)
+)
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamAssuredWorkloadsWorkloadSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"organization": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"workload": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var assuredWorkloadsWorkloadIamResource = GenericIamResource{
+	Type:                "assured_workloads_workload",
+	Description:         "Assured Workloads workload",
+	Api:                 "assured_workloads",
+	PathTemplate:        "organizations/{organization}/locations/{location}/workloads/{workload}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewAssuredWorkloadsWorkloadIamUpdater = NewGenericResourceIamUpdater(assuredWorkloadsWorkloadIamResource, IamAssuredWorkloadsWorkloadSchema)
+	AssuredWorkloadsWorkloadIdParseFunc   = genericIamIdParseFunc(assuredWorkloadsWorkloadIamResource)
+)
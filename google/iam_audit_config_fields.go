@@ -0,0 +1,142 @@
+package google
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// The fields of the audit configs, and of their audit log configs, known to the vendored Policy type.
+var (
+	knownIamAuditConfigFields    = map[string]bool{"service": true, "auditLogConfigs": true}
+	knownIamAuditLogConfigFields = map[string]bool{"logType": true, "exemptedMembers": true}
+)
+
+// iamAuditConfigFieldStore keeps the fields of the audit configs of the IAM policies read through the REST
+// backed updaters which the vendored Policy type doesn't know of, e.g. conditions added to the API since. They
+// would otherwise be dropped when the policy is decoded, and cleared by the next write of the policy, e.g. to
+// change an unrelated binding. The client libraries decode the policies themselves, so the fields of the
+// policies read through them can't be kept.
+type iamAuditConfigFieldStore struct {
+	mu sync.Mutex
+
+	// The unknown fields by resource URL, and by audit config, i.e. `{service}`, or audit log config, i.e.
+	// `{service} {logType}`.
+	fields map[string]map[string]map[string]json.RawMessage
+}
+
+var iamAuditConfigFields = &iamAuditConfigFieldStore{
+	fields: make(map[string]map[string]map[string]json.RawMessage),
+}
+
+type rawIamAuditConfig struct {
+	Service         string                       `json:"service"`
+	AuditLogConfigs []map[string]json.RawMessage `json:"auditLogConfigs"`
+}
+
+// keep records the unknown fields of the audit configs of the raw policy of the resource at resourceUrl,
+// replacing those recorded before.
+func (s *iamAuditConfigFieldStore) keep(resourceUrl string, raw []byte) {
+	var policy struct {
+		AuditConfigs []map[string]json.RawMessage `json:"auditConfigs"`
+	}
+	if err := json.Unmarshal(raw, &policy); err != nil {
+		return
+	}
+
+	fields := make(map[string]map[string]json.RawMessage)
+	for _, ac := range policy.AuditConfigs {
+		var config rawIamAuditConfig
+		if err := unmarshalRawFields(ac, &config); err != nil {
+			continue
+		}
+		if unknown := unknownRawFields(ac, knownIamAuditConfigFields); len(unknown) > 0 {
+			fields[config.Service] = unknown
+		}
+		for _, alc := range config.AuditLogConfigs {
+			var logType string
+			json.Unmarshal(alc["logType"], &logType)
+			if unknown := unknownRawFields(alc, knownIamAuditLogConfigFields); len(unknown) > 0 {
+				fields[config.Service+" "+logType] = unknown
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(fields) == 0 {
+		delete(s.fields, resourceUrl)
+		return
+	}
+	s.fields[resourceUrl] = fields
+}
+
+// restore returns the body of a request setting the policy of the resource at resourceUrl, with the unknown
+// fields recorded for its audit configs added back to those still in the policy. The body is returned as is
+// when there are none.
+func (s *iamAuditConfigFieldStore) restore(resourceUrl string, body interface{}) interface{} {
+	s.mu.Lock()
+	fields := s.fields[resourceUrl]
+	s.mu.Unlock()
+	if len(fields) == 0 {
+		return body
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return body
+	}
+	var req map[string]json.RawMessage
+	var policy map[string]json.RawMessage
+	var auditConfigs []map[string]json.RawMessage
+	if json.Unmarshal(b, &req) != nil || json.Unmarshal(req["policy"], &policy) != nil || json.Unmarshal(policy["auditConfigs"], &auditConfigs) != nil {
+		return body
+	}
+
+	for _, ac := range auditConfigs {
+		var config rawIamAuditConfig
+		if err := unmarshalRawFields(ac, &config); err != nil {
+			return body
+		}
+		addRawFields(ac, fields[config.Service])
+
+		for _, alc := range config.AuditLogConfigs {
+			var logType string
+			json.Unmarshal(alc["logType"], &logType)
+			addRawFields(alc, fields[config.Service+" "+logType])
+		}
+		if len(config.AuditLogConfigs) > 0 {
+			ac["auditLogConfigs"], _ = json.Marshal(config.AuditLogConfigs)
+		}
+	}
+
+	policy["auditConfigs"], _ = json.Marshal(auditConfigs)
+	req["policy"], _ = json.Marshal(policy)
+	return req
+}
+
+func unmarshalRawFields(fields map[string]json.RawMessage, v interface{}) error {
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func unknownRawFields(fields map[string]json.RawMessage, known map[string]bool) map[string]json.RawMessage {
+	unknown := make(map[string]json.RawMessage)
+	for k, v := range fields {
+		if !known[k] {
+			unknown[k] = v
+		}
+	}
+	return unknown
+}
+
+// addRawFields adds the fields to dst, unless they're already set.
+func addRawFields(dst, fields map[string]json.RawMessage) {
+	for k, v := range fields {
+		if _, ok := dst[k]; !ok {
+			dst[k] = v
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package google
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// testRestIamUpdater is a testIamUpdater whose policy is read and set through the REST helpers.
+type testRestIamUpdater struct {
+	*testIamUpdater
+	config      *Config
+	resourceUrl string
+}
+
+func (u *testRestIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	return getRestIamPolicy(u.config, "GET", u.resourceUrl)
+}
+
+func (u *testRestIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	return setRestIamPolicy(u.config, u.resourceUrl, policy)
+}
+
+func TestIamAuditConfigFields_bindingChange(t *testing.T) {
+	policy := `{
+  "etag": "BwV=",
+  "bindings": [{"role": "roles/editor", "members": ["user:admin@example.com"]}],
+  "auditConfigs": [{
+    "service": "allServices",
+    "auditLogConfigs": [
+      {"logType": "DATA_READ", "exemptedMembers": ["user:admin@example.com"], "ignoreChildExemptions": true},
+      {"logType": "DATA_WRITE"}
+    ],
+    "exemptedConditions": [{"expression": "request.time < timestamp('2030-01-01T00:00:00Z')"}]
+  }]
+}`
+	var setBodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, ":setIamPolicy") {
+			b, _ := ioutil.ReadAll(r.Body)
+			setBodies = append(setBodies, string(b))
+			var req struct {
+				Policy json.RawMessage `json:"policy"`
+			}
+			json.Unmarshal(b, &req)
+			policy = string(req.Policy)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(policy))
+	}))
+	defer server.Close()
+
+	config := &Config{client: server.Client()}
+	u := &testRestIamUpdater{testIamUpdater: newTestIamUpdater(), config: config, resourceUrl: server.URL + "/v1/resources/audited"}
+	newUpdaterFunc := func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		return u, nil
+	}
+
+	// An unrelated binding is added.
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+	if err := resourceIamBindingCreate(newUpdaterFunc)(d, config); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(setBodies) != 1 {
+		t.Fatalf("Expected the policy to be set once, got %d times", len(setBodies))
+	}
+
+	var req struct {
+		Policy struct {
+			Bindings     []map[string]interface{} `json:"bindings"`
+			AuditConfigs []map[string]interface{} `json:"auditConfigs"`
+		} `json:"policy"`
+	}
+	if err := json.Unmarshal([]byte(setBodies[0]), &req); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(req.Policy.Bindings) != 2 || len(req.Policy.AuditConfigs) != 1 {
+		t.Fatalf("Expected the binding to be added to the policy, got %s", setBodies[0])
+	}
+
+	// The fields of the live audit config unknown to the provider are set back as they were.
+	ac := req.Policy.AuditConfigs[0]
+	if _, ok := ac["exemptedConditions"]; !ok {
+		t.Fatalf("Expected the unknown field of the audit config to be kept, got %s", setBodies[0])
+	}
+	alcs := ac["auditLogConfigs"].([]interface{})
+	if v, ok := alcs[0].(map[string]interface{})["ignoreChildExemptions"]; !ok || v != true {
+		t.Fatalf("Expected the unknown field of the DATA_READ audit log config to be kept, got %s", setBodies[0])
+	}
+	if _, ok := alcs[1].(map[string]interface{})["ignoreChildExemptions"]; ok {
+		t.Fatalf("Expected the DATA_WRITE audit log config to be left as it was, got %s", setBodies[0])
+	}
+}
+
+func TestIamAuditConfigFieldStore_removedAuditConfig(t *testing.T) {
+	s := &iamAuditConfigFieldStore{fields: make(map[string]map[string]map[string]json.RawMessage)}
+	s.keep("https://example.com/v1/resources/audited", []byte(`{"auditConfigs": [{"service": "storage.googleapis.com", "exemptedConditions": []}]}`))
+
+	// The fields of an audit config removed from the policy aren't added back.
+	body := &cloudresourcemanager.SetIamPolicyRequest{
+		Policy: &cloudresourcemanager.Policy{
+			AuditConfigs: []*cloudresourcemanager.AuditConfig{{Service: "allServices"}},
+		},
+	}
+	b, _ := json.Marshal(s.restore("https://example.com/v1/resources/audited", body))
+	if strings.Contains(string(b), "exemptedConditions") {
+		t.Fatalf("Expected the fields of the removed audit config not to be set, got %s", b)
+	}
+
+	// The fields are only added back to the policy of the resource they were read from.
+	if s.restore("https://example.com/v1/resources/other", body) != body {
+		t.Fatalf("Expected the body of another resource to be returned as is")
+	}
+}
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamBatchJobSchema = map[string]*schema.Schema{
+	"job": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var batchJobIamResource = GenericIamResource{
+	Type:                "batch_job",
+	Description:         "Batch job",
+	Api:                 "batch",
+	PathTemplate:        "projects/{project}/locations/{location}/jobs/{job}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewBatchJobIamUpdater = NewGenericResourceIamUpdater(batchJobIamResource, IamBatchJobSchema)
+	BatchJobIdParseFunc   = genericIamIdParseFunc(batchJobIamResource)
+)
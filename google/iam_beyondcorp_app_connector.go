@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamBeyondcorpAppConnectorSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var beyondcorpAppConnectorIamResource = GenericIamResource{
+	Type:         "beyondcorp_app_connector",
+	Description:  "BeyondCorp app connector",
+	Api:          "beyondcorp",
+	PathTemplate: "projects/{project}/locations/{region}/appConnectors/{name}",
+}
+
+var (
+	NewBeyondcorpAppConnectorIamUpdater = NewGenericResourceIamUpdater(beyondcorpAppConnectorIamResource, IamBeyondcorpAppConnectorSchema)
+	BeyondcorpAppConnectorIdParseFunc   = genericIamIdParseFunc(beyondcorpAppConnectorIamResource)
+)
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"sync"
+)
+
+// iamBindingRegistry records the roles of the parents whose members are set by the IAM binding resources during
+// an apply, to catch the roles managed by several binding resources. Each of them sets the members of the role to
+// its own, so they overwrite each other's members on every apply. As the bindings of this provider have no
+// conditions, a binding is keyed by its parent and role. The parent is keyed by the mutex key of its updater, as
+// the IDs of parents of different types may be the same, e.g. those of AlloyDB and Edge Container clusters.
+type iamBindingRegistry struct {
+	mu       sync.Mutex
+	bindings map[string]bool
+}
+
+func newIamBindingRegistry() *iamBindingRegistry {
+	return &iamBindingRegistry{
+		bindings: make(map[string]bool),
+	}
+}
+
+// registerIamBinding records that role of the resource of updater is set by an IAM binding resource when the
+// duplicate bindings are detected, i.e. `detect_duplicate_iam_bindings` is set, and returns an error if another
+// binding resource already set it during the apply.
+func registerIamBinding(config *Config, updater ResourceIamUpdater, role string) error {
+	if config.iamBindings == nil {
+		return nil
+	}
+
+	if !config.iamBindings.register(updater.GetMutexKey(), role) {
+		return fmt.Errorf("Role %q of %s is managed by more than one IAM binding resource, which overwrite each other's members. "+
+			"Manage the members of the role in a single binding resource, or use member resources instead.", role, updater.DescribeResource())
+	}
+	return nil
+}
+
+// register records role of the parent keyed by parentKey, and returns false if it already was.
+func (r *iamBindingRegistry) register(parentKey, role string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := parentKey + " " + role
+	if r.bindings[key] {
+		return false
+	}
+	r.bindings[key] = true
+	return true
+}
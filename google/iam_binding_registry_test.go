@@ -0,0 +1,84 @@
+package google
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRegisterIamBinding_duplicateRole(t *testing.T) {
+	config := &Config{iamBindings: newIamBindingRegistry()}
+	u := newTestIamUpdater()
+	create := resourceIamBindingCreate(u.newUpdaterFunc())
+
+	newBinding := func(role string, members ...interface{}) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":    role,
+			"members": members,
+		})
+	}
+
+	if err := create(newBinding("roles/viewer", "user:admin@example.com"), config); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := create(newBinding("roles/editor", "user:admin@example.com"), config); err != nil {
+		t.Fatalf("Expected a binding of another role to be created, got %s", err)
+	}
+
+	// A second binding resource of the same role would overwrite the members of the first one.
+	err := create(newBinding("roles/viewer", "user:other@example.com"), config)
+	if err == nil || !strings.Contains(err.Error(), "more than one IAM binding resource") {
+		t.Fatalf("Expected an error for the duplicate binding, got %v", err)
+	}
+
+	expected := map[string]map[string]bool{
+		"roles/viewer": {"user:admin@example.com": true},
+		"roles/editor": {"user:admin@example.com": true},
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); !reflect.DeepEqual(bm, expected) {
+		t.Fatalf("Expected bindings %v, got %v", expected, bm)
+	}
+
+	// Updating the second binding resource fails too.
+	d := newBinding("roles/viewer", "user:other@example.com")
+	d.SetId("test-resource/roles/viewer")
+	if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, config); err == nil {
+		t.Fatalf("Expected an error for the duplicate binding on update")
+	}
+}
+
+func TestRegisterIamBinding_sameIdOfAnotherType(t *testing.T) {
+	config := &Config{iamBindings: newIamBindingRegistry()}
+	id := "projects/my-project/locations/us-central1/clusters/my-cluster"
+	alloyDB := &GenericResourceIamUpdater{resource: alloyDBClusterIamResource, resourceId: id, Config: config}
+	edgeContainer := &GenericResourceIamUpdater{resource: edgeContainerClusterIamResource, resourceId: id, Config: config}
+
+	if err := registerIamBinding(config, alloyDB, "roles/viewer"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	// The clusters are different parents, despite having the same ID.
+	if err := registerIamBinding(config, edgeContainer, "roles/viewer"); err != nil {
+		t.Fatalf("Expected the binding of another type of cluster to be registered, got %s", err)
+	}
+	if err := registerIamBinding(config, alloyDB, "roles/viewer"); err == nil {
+		t.Fatalf("Expected an error for the duplicate binding of the AlloyDB cluster")
+	}
+}
+
+func TestRegisterIamBinding_disabled(t *testing.T) {
+	config := &Config{}
+	u := newTestIamUpdater()
+	create := resourceIamBindingCreate(u.newUpdaterFunc())
+
+	for _, member := range []string{"user:admin@example.com", "user:other@example.com"} {
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":    "roles/viewer",
+			"members": []interface{}{member},
+		})
+		if err := create(d, config); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+}
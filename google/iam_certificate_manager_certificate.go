@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamCertificateManagerCertificateSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var certificateManagerCertificateIamResource = GenericIamResource{
+	Type:                "certificate_manager_certificate",
+	Description:         "Certificate Manager certificate",
+	Api:                 "certificate_manager",
+	PathTemplate:        "projects/{project}/locations/{location}/certificates/{name}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewCertificateManagerCertificateIamUpdater = NewGenericResourceIamUpdater(certificateManagerCertificateIamResource, IamCertificateManagerCertificateSchema)
+	CertificateManagerCertificateIdParseFunc   = genericIamIdParseFunc(certificateManagerCertificateIamResource)
+)
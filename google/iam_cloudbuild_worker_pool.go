@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamCloudBuildWorkerPoolSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var cloudBuildWorkerPoolIamResource = GenericIamResource{
+	Type:                "cloudbuild_worker_pool",
+	Description:         "Cloud Build worker pool",
+	Api:                 "cloudbuild",
+	PathTemplate:        "projects/{project}/locations/{location}/workerPools/{name}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewCloudBuildWorkerPoolIamUpdater = NewGenericResourceIamUpdater(cloudBuildWorkerPoolIamResource, IamCloudBuildWorkerPoolSchema)
+	CloudBuildWorkerPoolIdParseFunc   = genericIamIdParseFunc(cloudBuildWorkerPoolIamResource)
+)
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamCloudDeployTargetSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"target": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var cloudDeployTargetIamResource = GenericIamResource{
+	Type:         "clouddeploy_target",
+	Description:  "Cloud Deploy target",
+	Api:          "clouddeploy",
+	PathTemplate: "projects/{project}/locations/{location}/targets/{target}",
+}
+
+var (
+	NewCloudDeployTargetIamUpdater = NewGenericResourceIamUpdater(cloudDeployTargetIamResource, IamCloudDeployTargetSchema)
+	CloudDeployTargetIdParseFunc   = genericIamIdParseFunc(cloudDeployTargetIamResource)
+)
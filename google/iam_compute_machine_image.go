@@ -0,0 +1,31 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamComputeMachineImageSchema = map[string]*schema.Schema{
+	"machine_image": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+// The vendored compute client doesn't have the IAM methods of machine images, snapshots and reservations, which
+// are called through REST.
+var computeMachineImageIamResource = GenericIamResource{
+	Type:         "compute_machine_image",
+	Description:  "compute machine image",
+	Api:          "compute",
+	PathTemplate: "projects/{project}/global/machineImages/{machine_image}",
+	ComputeStyle: true,
+}
+
+var (
+	NewComputeMachineImageIamUpdater = NewGenericResourceIamUpdater(computeMachineImageIamResource, IamComputeMachineImageSchema)
+	ComputeMachineImageIdParseFunc   = genericIamIdParseFunc(computeMachineImageIamResource)
+)
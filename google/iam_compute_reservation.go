@@ -0,0 +1,35 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamComputeReservationSchema = map[string]*schema.Schema{
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"reservation": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"zone": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var computeReservationIamResource = GenericIamResource{
+	Type:                "compute_reservation",
+	Description:         "compute reservation",
+	Api:                 "compute",
+	PathTemplate:        "projects/{project}/zones/{zone}/reservations/{reservation}",
+	ComputeStyle:        true,
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewComputeReservationIamUpdater = NewGenericResourceIamUpdater(computeReservationIamResource, IamComputeReservationSchema)
+	ComputeReservationIdParseFunc   = genericIamIdParseFunc(computeReservationIamResource)
+)
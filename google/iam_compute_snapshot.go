@@ -0,0 +1,29 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamComputeSnapshotSchema = map[string]*schema.Schema{
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"snapshot": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var computeSnapshotIamResource = GenericIamResource{
+	Type:         "compute_snapshot",
+	Description:  "compute snapshot",
+	Api:          "compute",
+	PathTemplate: "projects/{project}/global/snapshots/{snapshot}",
+	ComputeStyle: true,
+}
+
+var (
+	NewComputeSnapshotIamUpdater = NewGenericResourceIamUpdater(computeSnapshotIamResource, IamComputeSnapshotSchema)
+	ComputeSnapshotIdParseFunc   = genericIamIdParseFunc(computeSnapshotIamResource)
+)
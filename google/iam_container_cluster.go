@@ -0,0 +1,38 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamContainerClusterSchema = map[string]*schema.Schema{
+	"cluster": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+// GKE clusters don't expose an IAM policy through the container API. Access to a cluster
+// through the Connect gateway is instead governed by the IAM policy of the GKE Hub membership
+// the cluster is registered as, so the cluster IAM resources manage the policy of that membership.
+// The membership is expected to have the name of the cluster, as the registration tooling does by default.
+var containerClusterIamResource = GenericIamResource{
+	Type:         "container_cluster",
+	Description:  "container cluster membership",
+	Api:          "gke_hub",
+	PathTemplate: "projects/{project}/locations/{location}/memberships/{cluster}",
+}
+
+var (
+	NewContainerClusterIamUpdater = NewGenericResourceIamUpdater(containerClusterIamResource, IamContainerClusterSchema)
+	ContainerClusterIdParseFunc   = genericIamIdParseFunc(containerClusterIamResource)
+)
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamDataFusionInstanceSchema = map[string]*schema.Schema{
+	"instance": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var dataFusionInstanceIamResource = GenericIamResource{
+	Type:         "data_fusion_instance",
+	Description:  "Data Fusion instance",
+	Api:          "data_fusion",
+	PathTemplate: "projects/{project}/locations/{region}/instances/{instance}",
+}
+
+var (
+	NewDataFusionInstanceIamUpdater = NewGenericResourceIamUpdater(dataFusionInstanceIamResource, IamDataFusionInstanceSchema)
+	DataFusionInstanceIdParseFunc   = genericIamIdParseFunc(dataFusionInstanceIamResource)
+)
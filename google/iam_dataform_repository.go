@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamDataformRepositorySchema = map[string]*schema.Schema{
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"repository": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var dataformRepositoryIamResource = GenericIamResource{
+	Type:         "dataform_repository",
+	Description:  "Dataform repository",
+	Api:          "dataform",
+	PathTemplate: "projects/{project}/locations/{region}/repositories/{repository}",
+}
+
+var (
+	NewDataformRepositoryIamUpdater = NewGenericResourceIamUpdater(dataformRepositoryIamResource, IamDataformRepositorySchema)
+	DataformRepositoryIdParseFunc   = genericIamIdParseFunc(dataformRepositoryIamResource)
+)
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamDataprocMetastoreServiceSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"service": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var dataprocMetastoreServiceIamResource = GenericIamResource{
+	Type:         "dataproc_metastore_service",
+	Description:  "Dataproc Metastore service",
+	Api:          "dataproc_metastore",
+	PathTemplate: "projects/{project}/locations/{location}/services/{service}",
+}
+
+var (
+	NewDataprocMetastoreServiceIamUpdater = NewGenericResourceIamUpdater(dataprocMetastoreServiceIamResource, IamDataprocMetastoreServiceSchema)
+	DataprocMetastoreServiceIdParseFunc   = genericIamIdParseFunc(dataprocMetastoreServiceIamResource)
+)
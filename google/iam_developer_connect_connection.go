@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamDeveloperConnectConnectionSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var developerConnectConnectionIamResource = GenericIamResource{
+	Type:         "developer_connect_connection",
+	Description:  "Developer Connect connection",
+	Api:          "developer_connect",
+	PathTemplate: "projects/{project}/locations/{location}/connections/{name}",
+}
+
+var (
+	NewDeveloperConnectConnectionIamUpdater = NewGenericResourceIamUpdater(developerConnectConnectionIamResource, IamDeveloperConnectConnectionSchema)
+	DeveloperConnectConnectionIdParseFunc   = genericIamIdParseFunc(developerConnectConnectionIamResource)
+)
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamDiscoveryEngineDataStoreSchema = map[string]*schema.Schema{
+	"data_store": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var discoveryEngineDataStoreIamResource = GenericIamResource{
+	Type:         "discovery_engine_data_store",
+	Description:  "Discovery Engine data store",
+	Api:          "discovery_engine",
+	PathTemplate: "projects/{project}/locations/{location}/collections/default_collection/dataStores/{data_store}",
+}
+
+var (
+	NewDiscoveryEngineDataStoreIamUpdater = NewGenericResourceIamUpdater(discoveryEngineDataStoreIamResource, IamDiscoveryEngineDataStoreSchema)
+	DiscoveryEngineDataStoreIdParseFunc   = genericIamIdParseFunc(discoveryEngineDataStoreIamResource)
+)
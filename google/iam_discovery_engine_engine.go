@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamDiscoveryEngineEngineSchema = map[string]*schema.Schema{
+	"engine": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var discoveryEngineEngineIamResource = GenericIamResource{
+	Type:         "discovery_engine_engine",
+	Description:  "Discovery Engine engine",
+	Api:          "discovery_engine",
+	PathTemplate: "projects/{project}/locations/{location}/collections/default_collection/engines/{engine}",
+}
+
+var (
+	NewDiscoveryEngineEngineIamUpdater = NewGenericResourceIamUpdater(discoveryEngineEngineIamResource, IamDiscoveryEngineEngineSchema)
+	DiscoveryEngineEngineIdParseFunc   = genericIamIdParseFunc(discoveryEngineEngineIamResource)
+)
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamEdgeContainerClusterSchema = map[string]*schema.Schema{
+	"cluster": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var edgeContainerClusterIamResource = GenericIamResource{
+	Type:                "edgecontainer_cluster",
+	Description:         "Distributed Cloud Edge cluster",
+	Api:                 "edgecontainer",
+	PathTemplate:        "projects/{project}/locations/{location}/clusters/{cluster}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewEdgeContainerClusterIamUpdater = NewGenericResourceIamUpdater(edgeContainerClusterIamResource, IamEdgeContainerClusterSchema)
+	EdgeContainerClusterIdParseFunc   = genericIamIdParseFunc(edgeContainerClusterIamResource)
+)
@@ -0,0 +1,107 @@
+package google
+
+import (
+	"fmt"
+	"github.com/hashicorp/errwrap"
+	"google.golang.org/api/googleapi"
+	"regexp"
+	"strings"
+)
+
+// IamError is an error of the API of a resource reading or setting its IAM policy. When the cause of the error
+// is a common one, Remediation tells how to fix it, as the errors of the API are often terse.
+type IamError struct {
+	// The HTTP status code, and the reason given by the API, e.g. `badRequest`.
+	HttpCode int
+	Reason   string
+
+	// Remediation is empty when the cause of the error isn't known.
+	Remediation string
+
+	Err error
+}
+
+func (e *IamError) Error() string {
+	if e.Remediation == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s\n\n%s", e.Err, e.Remediation)
+}
+
+// WrappedErrors returns the underlying error, so that the API error can still be found with errwrap.
+func (e *IamError) WrappedErrors() []error {
+	return []error{e.Err}
+}
+
+var (
+	iamDomainRestrictedRegexp = regexp.MustCompile(`(?i)do(es)? not belong to a permitted customer`)
+	iamInvalidMemberRegexp    = regexp.MustCompile(`(?i)(does not exist|invalid (member|principal))`)
+	iamPolicyVersionRegexp    = regexp.MustCompile(`(?i)\bversion\b`)
+)
+
+// iamErrorRemediations maps the known causes of IAM errors to how to fix them, in the order they're checked in.
+var iamErrorRemediations = []struct {
+	codes       []int
+	message     *regexp.Regexp
+	remediation string
+}{
+	{
+		codes:       []int{400, 412},
+		message:     iamDomainRestrictedRegexp,
+		remediation: "A member of the policy isn't in a domain allowed by the `constraints/iam.allowedPolicyMemberDomains` organization policy constraint. Grant the role to members of an allowed domain, or have the constraint allow the domain of the member.",
+	},
+	{
+		codes:       []int{400},
+		message:     iamInvalidMemberRegexp,
+		remediation: "A member of the policy doesn't exist or isn't valid. Check that it's spelled with the right type, e.g. `serviceAccount:` for a service account, and that it wasn't deleted.",
+	},
+	{
+		codes:       []int{400},
+		message:     iamPolicyVersionRegexp,
+		remediation: "The version of the policy isn't supported by the resource. Remove the version from `policy_data` to keep the version of the live policy.",
+	},
+	{
+		codes:       []int{403},
+		remediation: "The credentials of the provider aren't allowed to read or set the IAM policy of the resource. Grant them a role with the `getIamPolicy` and `setIamPolicy` permissions of its service, e.g. `roles/resourcemanager.projectIamAdmin` on a project.",
+	},
+}
+
+// newIamError returns err as an *IamError if it's, or wraps, an API error, or err as is otherwise.
+func newIamError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*IamError); ok {
+		return err
+	}
+
+	gerr, ok := err.(*googleapi.Error)
+	if !ok {
+		if !errwrap.ContainsType(err, &googleapi.Error{}) {
+			return err
+		}
+		gerr = errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	}
+
+	iamErr := &IamError{
+		HttpCode: gerr.Code,
+		Err:      err,
+	}
+	messages := []string{gerr.Message}
+	for _, item := range gerr.Errors {
+		if iamErr.Reason == "" {
+			iamErr.Reason = item.Reason
+		}
+		messages = append(messages, item.Message)
+	}
+	message := strings.Join(messages, "\n")
+
+	for _, r := range iamErrorRemediations {
+		if !isGoogleApiErrorWithCode(gerr, r.codes...) || (r.message != nil && !r.message.MatchString(message)) {
+			continue
+		}
+		iamErr.Remediation = r.remediation
+		break
+	}
+	return iamErr
+}
@@ -0,0 +1,142 @@
+package google
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+)
+
+func TestNewIamError(t *testing.T) {
+	cases := map[string]struct {
+		Err         error
+		Reason      string
+		Remediation string
+	}{
+		"domain restriction": {
+			Err: &googleapi.Error{
+				Code:    400,
+				Message: "One or more users named in the policy do not belong to a permitted customer.",
+				Errors:  []googleapi.ErrorItem{{Reason: "failedPrecondition", Message: "One or more users named in the policy do not belong to a permitted customer."}},
+			},
+			Reason:      "failedPrecondition",
+			Remediation: "`constraints/iam.allowedPolicyMemberDomains`",
+		},
+		"invalid member": {
+			Err: &googleapi.Error{
+				Code:    400,
+				Message: "User jane@example.com does not exist.",
+				Errors:  []googleapi.ErrorItem{{Reason: "badRequest", Message: "User jane@example.com does not exist."}},
+			},
+			Reason:      "badRequest",
+			Remediation: "A member of the policy doesn't exist or isn't valid.",
+		},
+		"version mismatch": {
+			Err: &googleapi.Error{
+				Code:    400,
+				Message: "Invalid IAM policy version 3 for this resource.",
+			},
+			Remediation: "Remove the version from `policy_data`",
+		},
+		"permission denied": {
+			Err: errwrap.Wrapf("Error setting IAM policy for test resource: {{err}}", &googleapi.Error{
+				Code:    403,
+				Message: "The caller does not have permission",
+				Errors:  []googleapi.ErrorItem{{Reason: "forbidden", Message: "The caller does not have permission"}},
+			}),
+			Reason:      "forbidden",
+			Remediation: "aren't allowed to read or set the IAM policy of the resource",
+		},
+		"unknown cause": {
+			Err: &googleapi.Error{
+				Code:    500,
+				Message: "Internal error encountered.",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		err := newIamError(tc.Err)
+		iamErr, ok := err.(*IamError)
+		if !ok {
+			t.Errorf("%s: Expected an *IamError, got %T", tn, err)
+			continue
+		}
+
+		gerr := errwrap.GetType(tc.Err, &googleapi.Error{}).(*googleapi.Error)
+		if iamErr.HttpCode != gerr.Code || iamErr.Reason != tc.Reason {
+			t.Errorf("%s: Expected code %d and reason %q, got code %d and reason %q", tn, gerr.Code, tc.Reason, iamErr.HttpCode, iamErr.Reason)
+		}
+		if tc.Remediation == "" {
+			if iamErr.Remediation != "" || err.Error() != tc.Err.Error() {
+				t.Errorf("%s: Expected no remediation, got %q", tn, err)
+			}
+			continue
+		}
+		if !strings.Contains(iamErr.Remediation, tc.Remediation) || !strings.HasPrefix(err.Error(), tc.Err.Error()+"\n\n") {
+			t.Errorf("%s: Expected the error to be followed by a remediation containing %q, got %q", tn, tc.Remediation, err)
+		}
+		if !isGoogleApiErrorWithCode(err, gerr.Code) {
+			t.Errorf("%s: Expected the API error to still be found in %q", tn, err)
+		}
+	}
+
+	if err := errors.New("not an API error"); newIamError(err) != err {
+		t.Errorf("Expected an error which isn't an API error to be returned as is")
+	}
+}
+
+// testDeniedIamUpdater is a testIamUpdater whose policy can't be set.
+type testDeniedIamUpdater struct {
+	*testIamUpdater
+}
+
+func (u *testDeniedIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	return nil, &googleapi.Error{Code: 403, Message: "Permission 'test.resources.setIamPolicy' denied on resource."}
+}
+
+func TestIamBindingCreate_iamError(t *testing.T) {
+	u := &testDeniedIamUpdater{newTestIamUpdater()}
+	newUpdaterFunc := func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		return u, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+
+	err := resourceIamBindingCreate(newUpdaterFunc)(d, &Config{})
+	iamErr, ok := err.(*IamError)
+	if !ok {
+		t.Fatalf("Expected an *IamError, got %v", err)
+	}
+	if iamErr.HttpCode != 403 || !strings.Contains(err.Error(), "Error applying IAM policy for test resource") || !strings.Contains(err.Error(), "`setIamPolicy` permissions") {
+		t.Fatalf("Expected the permission denied error to be followed by its remediation, got %q", err)
+	}
+}
+
+func TestProjectIamUpdater_setConflict(t *testing.T) {
+	client := &http.Client{Transport: testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		return testResponse(409, `{"error":{"code":409,"message":"There were concurrent policy changes.","status":"ABORTED"}}`), nil
+	})}
+	crm, err := cloudresourcemanager.New(client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	u := &ProjectIamUpdater{resourceId: "my-project", Config: &Config{clientResourceManager: crm}}
+
+	// The API error is kept, so that the conflict is retried.
+	_, err = u.SetResourceIamPolicy(&cloudresourcemanager.Policy{})
+	if !isConflictError(err) {
+		t.Fatalf("Expected a conflict error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `Error setting IAM policy for project "my-project"`) {
+		t.Fatalf("Expected the error to describe the project, got %q", err)
+	}
+}
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamEventarcChannelSchema = map[string]*schema.Schema{
+	"channel": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var eventarcChannelIamResource = GenericIamResource{
+	Type:         "eventarc_channel",
+	Description:  "Eventarc channel",
+	Api:          "eventarc",
+	PathTemplate: "projects/{project}/locations/{location}/channels/{channel}",
+}
+
+var (
+	NewEventarcChannelIamUpdater = NewGenericResourceIamUpdater(eventarcChannelIamResource, IamEventarcChannelSchema)
+	EventarcChannelIdParseFunc   = genericIamIdParseFunc(eventarcChannelIamResource)
+)
@@ -0,0 +1,30 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamFirestoreDatabaseSchema = map[string]*schema.Schema{
+	"database": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "(default)",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var firestoreDatabaseIamResource = GenericIamResource{
+	Type:                "firestore_database",
+	Description:         "Firestore database",
+	Api:                 "firestore",
+	PathTemplate:        "projects/{project}/databases/{database}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewFirestoreDatabaseIamUpdater = NewGenericResourceIamUpdater(firestoreDatabaseIamResource, IamFirestoreDatabaseSchema)
+	FirestoreDatabaseIdParseFunc   = genericIamIdParseFunc(firestoreDatabaseIamResource)
+)
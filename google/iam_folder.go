@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
 	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
@@ -33,7 +34,7 @@ func (u *FolderIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy,
 		&resourceManagerV2Beta1.GetIamPolicyRequest{}).Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving IAM policy for %s: %s", u.DescribeResource(), err)
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
 	v1Policy, err := v2BetaPolicyToV1(p)
@@ -44,21 +45,32 @@ func (u *FolderIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy,
 	return v1Policy, nil
 }
 
-func (u *FolderIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+func (u *FolderIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
 	v2BetaPolicy, err := v1PolicyToV2Beta(policy)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = u.Config.clientResourceManagerV2Beta1.Folders.SetIamPolicy(u.folderId, &resourceManagerV2Beta1.SetIamPolicyRequest{
+	p, err := u.Config.clientResourceManagerV2Beta1.Folders.SetIamPolicy(u.folderId, &resourceManagerV2Beta1.SetIamPolicyRequest{
 		Policy: v2BetaPolicy,
 	}).Do()
 
 	if err != nil {
-		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	return nil
+	v1Policy, err := v2BetaPolicyToV1(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1Policy, nil
+}
+
+func (u *FolderIamUpdater) CheckParentExists() error {
+	_, err := u.Config.clientResourceManagerV2Beta1.Folders.Get(u.folderId).Fields("name").Do()
+
+	return iamParentError(u, err)
 }
 
 func (u *FolderIamUpdater) GetResourceId() string {
@@ -73,6 +85,18 @@ func (u *FolderIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("folder %q", u.folderId)
 }
 
+func (u *FolderIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return IamResourceDescriptor{
+		ResourceType: "folder",
+		ResourceId:   u.GetResourceId(),
+	}
+}
+
+func FolderIdParseFunc(d *schema.ResourceData, config *Config) error {
+	d.Set("folder", canonicalFolderId(d.Id()))
+	return nil
+}
+
 func canonicalFolderId(folder string) string {
 	if strings.HasPrefix(folder, "folders/") {
 		return folder
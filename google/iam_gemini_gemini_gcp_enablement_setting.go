@@ -0,0 +1,35 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamGeminiGcpEnablementSettingSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"setting": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var geminiGcpEnablementSettingIamResource = GenericIamResource{
+	Type:                "gemini_gemini_gcp_enablement_setting",
+	Description:         "Gemini for Google Cloud enablement setting",
+	Api:                 "gemini",
+	PathTemplate:        "projects/{project}/locations/{location}/geminiGcpEnablementSettings/{setting}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewGeminiGcpEnablementSettingIamUpdater = NewGenericResourceIamUpdater(geminiGcpEnablementSettingIamResource, IamGeminiGcpEnablementSettingSchema)
+	GeminiGcpEnablementSettingIdParseFunc   = genericIamIdParseFunc(geminiGcpEnablementSettingIamResource)
+)
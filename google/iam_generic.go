@@ -0,0 +1,185 @@
+package google
+
+import (
+	"fmt"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"regexp"
+	"strings"
+)
+
+// A GenericIamResource describes the resources of an API exposing the standard `getIamPolicy` and
+// `setIamPolicy` methods, for which no client library is vendored.
+type GenericIamResource struct {
+	// The type of the resources in the descriptors and mutex keys of their policies, e.g. `batch_job`.
+	Type string
+
+	// The name of the resources in messages, e.g. `Batch job`.
+	Description string
+
+	// The API of the resources, e.g. `batch`, whose base path is the one of the provider's Config. The `{field}`
+	// placeholders of the base path of an API only served by regional endpoints are replaced like those of
+	// PathTemplate.
+	Api string
+
+	// The version of Api, e.g. `v1`, appended to its base path for the APIs with a client library, whose base
+	// paths exclude their version.
+	ApiVersion string
+
+	// The path of the resources relative to the base path of Api, e.g.
+	// `projects/{project}/locations/{location}/jobs/{job}`. Each `{field}` placeholder is replaced by the value of
+	// a field of the parent specific schema of the IAM resources. The `project` and `region` fields default to
+	// those of the provider.
+	PathTemplate string
+
+	// The HTTP method of `getIamPolicy`, GET if empty. Some APIs only accept a POST.
+	GetIamPolicyMethod string
+
+	// Whether the IAM methods are sub-resources of the resources, i.e. `{resource}/getIamPolicy`, as in the
+	// Compute Engine API, rather than custom methods, i.e. `{resource}:getIamPolicy`.
+	ComputeStyle bool
+
+	// Whether only some of the resources support IAM policies, in which case a policy that can't be found is
+	// reported as possibly unsupported.
+	IamMayBeUnsupported bool
+}
+
+// GenericResourceIamUpdater manages the IAM policy of a resource described by a GenericIamResource.
+type GenericResourceIamUpdater struct {
+	resource   GenericIamResource
+	basePath   string
+	resourceId string
+	Config     *Config
+}
+
+// NewGenericResourceIamUpdater returns the updater function of the IAM resources of resource, whose parent
+// specific schema is parentFields.
+//
+// The returned function, parentFields and genericIamIdParseFunc(resource) are meant to be passed to the IAM
+// resource factories.
+func NewGenericResourceIamUpdater(resource GenericIamResource, parentFields map[string]*schema.Schema) newResourceIamUpdaterFunc {
+	return func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		basePath, err := expandGenericIamTemplate(d, config, config.basePath(resource.Api), parentFields)
+		if err != nil {
+			return nil, err
+		}
+		if resource.ApiVersion != "" {
+			basePath += resource.ApiVersion + "/"
+		}
+
+		resourceId, err := expandGenericIamTemplate(d, config, resource.PathTemplate, parentFields)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GenericResourceIamUpdater{
+			resource:   resource,
+			basePath:   basePath,
+			resourceId: resourceId,
+			Config:     config,
+		}, nil
+	}
+}
+
+func (u *GenericResourceIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	var p *cloudresourcemanager.Policy
+	var err error
+	if u.resource.ComputeStyle {
+		p, err = getComputeRestIamPolicy(u.Config, u.basePath+u.resourceId)
+	} else {
+		method := u.resource.GetIamPolicyMethod
+		if method == "" {
+			method = "GET"
+		}
+		p, err = getRestIamPolicy(u.Config, method, u.basePath+u.resourceId)
+	}
+	if u.resource.IamMayBeUnsupported && isIamUnsupportedError(err) {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s, which doesn't exist or doesn't support IAM policies: {{err}}", u.DescribeResource()), err)
+	}
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *GenericResourceIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	var p *cloudresourcemanager.Policy
+	var err error
+	if u.resource.ComputeStyle {
+		p, err = setComputeRestIamPolicy(u.Config, u.basePath+u.resourceId, policy)
+	} else {
+		p, err = setRestIamPolicy(u.Config, u.basePath+u.resourceId, policy)
+	}
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *GenericResourceIamUpdater) CheckParentExists() error {
+	err := sendRequest(u.Config, "GET", u.basePath+u.resourceId+"?fields=name", nil, nil)
+
+	return iamParentError(u, err)
+}
+
+func (u *GenericResourceIamUpdater) GetResourceId() string {
+	return u.resourceId
+}
+
+func (u *GenericResourceIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-%s-%s", strings.Replace(u.resource.Type, "_", "-", -1), u.resourceId)
+}
+
+func (u *GenericResourceIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("%s %q", u.resource.Description, u.resourceId)
+}
+
+func (u *GenericResourceIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return newRestIamResourceDescriptor(u.resource.Type, u.resourceId)
+}
+
+// genericIamIdParseFunc returns the import ID parser of the IAM resources of resource, which accepts the path of
+// the resource.
+func genericIamIdParseFunc(resource GenericIamResource) resourceIdParserFunc {
+	return func(d *schema.ResourceData, config *Config) error {
+		return parseIamImportId(d, resource.PathTemplate)
+	}
+}
+
+var genericIamPlaceholderRegexp = regexp.MustCompile(`\{(\w+)\}`)
+
+func expandGenericIamTemplate(d *schema.ResourceData, config *Config, template string, parentFields map[string]*schema.Schema) (string, error) {
+	var err error
+	expanded := genericIamPlaceholderRegexp.ReplaceAllStringFunc(template, func(placeholder string) string {
+		if err != nil {
+			return placeholder
+		}
+		field := placeholder[1 : len(placeholder)-1]
+		if _, ok := parentFields[field]; !ok {
+			err = fmt.Errorf("The IAM resource template %q references %q, which isn't a field of the resource", template, field)
+			return placeholder
+		}
+
+		var value string
+		switch field {
+		case "project":
+			value, err = getProject(d, config)
+		case "region":
+			value, err = getRegion(d, config)
+		default:
+			value = d.Get(field).(string)
+		}
+		if err == nil && value == "" {
+			err = fmt.Errorf("%q is required to build %q", field, template)
+		}
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return expanded, nil
+}
@@ -0,0 +1,720 @@
+package google
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// testGenericIamUpdater returns the updater of resource for its path resourceId, whose fields are parsed from it as
+// on import.
+func testGenericIamUpdater(t *testing.T, resource GenericIamResource, parentFields map[string]*schema.Schema, config *Config, resourceId string) ResourceIamUpdater {
+	d := schema.TestResourceDataRaw(t, parentFields, map[string]interface{}{})
+	d.SetId(resourceId)
+	if err := genericIamIdParseFunc(resource)(d, config); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	updater, err := NewGenericResourceIamUpdater(resource, parentFields)(d, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return updater
+}
+
+func TestGenericResourceIamUpdater(t *testing.T) {
+	cases := map[string]struct {
+		resource         GenericIamResource
+		basePath         string
+		parentFields     map[string]*schema.Schema
+		raw              map[string]interface{}
+		expectedId       string
+		expectedRequests []string
+	}{
+		"project and region": {
+			resource: GenericIamResource{
+				Type:         "widget",
+				Description:  "widget",
+				Api:          "widgets",
+				PathTemplate: "projects/{project}/locations/{region}/widgets/{widget}",
+			},
+			basePath: "https://widgets.googleapis.com/v1/",
+			parentFields: map[string]*schema.Schema{
+				"project": {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"region":  {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"widget":  {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			raw: map[string]interface{}{
+				"region": "us-central1",
+				"widget": "my-widget",
+			},
+			expectedId: "projects/my-project/locations/us-central1/widgets/my-widget",
+			expectedRequests: []string{
+				"GET https://widgets.googleapis.com/v1/projects/my-project/locations/us-central1/widgets/my-widget:getIamPolicy",
+				"POST https://widgets.googleapis.com/v1/projects/my-project/locations/us-central1/widgets/my-widget:setIamPolicy",
+			},
+		},
+		"organization": {
+			resource: GenericIamResource{
+				Type:         "gadget",
+				Description:  "gadget",
+				Api:          "gadgets",
+				PathTemplate: "organizations/{organization}/gadgets/{gadget}",
+			},
+			basePath: "https://gadgets.googleapis.com/v2beta/",
+			parentFields: map[string]*schema.Schema{
+				"organization": {Type: schema.TypeString, Required: true, ForceNew: true},
+				"gadget":       {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			raw: map[string]interface{}{
+				"organization": "123456789",
+				"gadget":       "my-gadget",
+			},
+			expectedId: "organizations/123456789/gadgets/my-gadget",
+			expectedRequests: []string{
+				"GET https://gadgets.googleapis.com/v2beta/organizations/123456789/gadgets/my-gadget:getIamPolicy",
+				"POST https://gadgets.googleapis.com/v2beta/organizations/123456789/gadgets/my-gadget:setIamPolicy",
+			},
+		},
+		"regional endpoint": {
+			resource: GenericIamResource{
+				Type:               "gizmo",
+				Description:        "gizmo",
+				Api:                "gizmos",
+				PathTemplate:       "projects/{project}/locations/{region}/gizmos/{gizmo}",
+				GetIamPolicyMethod: "POST",
+			},
+			basePath: "https://{region}-gizmos.googleapis.com/v1/",
+			parentFields: map[string]*schema.Schema{
+				"project": {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"region":  {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"gizmo":   {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			raw: map[string]interface{}{
+				"region": "europe-west1",
+				"gizmo":  "my-gizmo",
+			},
+			expectedId: "projects/my-project/locations/europe-west1/gizmos/my-gizmo",
+			expectedRequests: []string{
+				"POST https://europe-west1-gizmos.googleapis.com/v1/projects/my-project/locations/europe-west1/gizmos/my-gizmo:getIamPolicy",
+				"POST https://europe-west1-gizmos.googleapis.com/v1/projects/my-project/locations/europe-west1/gizmos/my-gizmo:setIamPolicy",
+			},
+		},
+		"compute style": {
+			resource: GenericIamResource{
+				Type:         "compute_doohickey",
+				Description:  "compute doohickey",
+				Api:          "compute",
+				PathTemplate: "projects/{project}/zones/{zone}/doohickeys/{doohickey}",
+				ComputeStyle: true,
+			},
+			parentFields: map[string]*schema.Schema{
+				"project":   {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"zone":      {Type: schema.TypeString, Required: true, ForceNew: true},
+				"doohickey": {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			raw: map[string]interface{}{
+				"zone":      "us-central1-a",
+				"doohickey": "my-doohickey",
+			},
+			expectedId: "projects/my-project/zones/us-central1-a/doohickeys/my-doohickey",
+			expectedRequests: []string{
+				"GET https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/doohickeys/my-doohickey/getIamPolicy",
+				"POST https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/doohickeys/my-doohickey/setIamPolicy",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		var requests []string
+		config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+			requests = append(requests, req.Method+" "+req.URL.String())
+			return testResponse(200, `{"etag":"BwVZ1Q==","bindings":[{"role":"roles/viewer","members":["user:admin@example.com"]}]}`), nil
+		})
+		if tc.basePath != "" {
+			config.BasePaths = map[string]string{tc.resource.Api: tc.basePath}
+		}
+
+		d := schema.TestResourceDataRaw(t, tc.parentFields, tc.raw)
+		updater, err := NewGenericResourceIamUpdater(tc.resource, tc.parentFields)(d, config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+
+		if updater.GetResourceId() != tc.expectedId {
+			t.Fatalf("%s: expected resource id %q, got %q", tn, tc.expectedId, updater.GetResourceId())
+		}
+		if descriptor := updater.GetResourceDescriptor(); descriptor.ResourceType != tc.resource.Type {
+			t.Fatalf("%s: expected resource type %q, got %q", tn, tc.resource.Type, descriptor.ResourceType)
+		}
+
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if len(p.Bindings) != 1 || p.Bindings[0].Role != "roles/viewer" {
+			t.Fatalf("%s: unexpected policy %+v", tn, p)
+		}
+
+		if _, err = updater.SetResourceIamPolicy(&cloudresourcemanager.Policy{}); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+
+		if fmt.Sprint(requests) != fmt.Sprint(tc.expectedRequests) {
+			t.Fatalf("%s: expected requests %v, got %v", tn, tc.expectedRequests, requests)
+		}
+	}
+}
+
+func TestGenericResourceIamUpdater_unsupported(t *testing.T) {
+	parentFields := map[string]*schema.Schema{
+		"widget": {Type: schema.TypeString, Required: true, ForceNew: true},
+	}
+	d := schema.TestResourceDataRaw(t, parentFields, map[string]interface{}{
+		"widget": "my-widget",
+	})
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		return testResponse(404, `{"error":{"code":404,"message":"Not found"}}`), nil
+	})
+	config.BasePaths = map[string]string{"widgets": "https://widgets.googleapis.com/v1/"}
+
+	for _, mayBeUnsupported := range []bool{false, true} {
+		resource := GenericIamResource{
+			Type:                "widget",
+			Description:         "widget",
+			Api:                 "widgets",
+			PathTemplate:        "widgets/{widget}",
+			IamMayBeUnsupported: mayBeUnsupported,
+		}
+		updater, err := NewGenericResourceIamUpdater(resource, parentFields)(d, config)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		_, err = updater.GetResourceIamPolicy()
+		if err == nil || !isIamUnsupportedError(err) {
+			t.Fatalf("Expected the API error to be kept, got %v", err)
+		}
+		if hinted := strings.Contains(err.Error(), "doesn't support IAM policies"); hinted != mayBeUnsupported {
+			t.Fatalf("Expected the hint only when IAM may be unsupported (%t), got %q", mayBeUnsupported, err)
+		}
+	}
+}
+
+func TestGenericResourceIamUpdater_unknownField(t *testing.T) {
+	parentFields := map[string]*schema.Schema{
+		"widget": {Type: schema.TypeString, Required: true, ForceNew: true},
+	}
+	d := schema.TestResourceDataRaw(t, parentFields, map[string]interface{}{
+		"widget": "my-widget",
+	})
+
+	resource := GenericIamResource{
+		Type:         "widget",
+		Description:  "widget",
+		Api:          "widgets",
+		PathTemplate: "folders/{folder}/widgets/{widget}",
+	}
+	_, err := NewGenericResourceIamUpdater(resource, parentFields)(d, &Config{})
+	if err == nil || !strings.Contains(err.Error(), `"folder"`) {
+		t.Fatalf("Expected an error about the unknown field, got %v", err)
+	}
+}
+
+func TestGenericIamIdParseFunc(t *testing.T) {
+	parentFields := map[string]*schema.Schema{
+		"project": {Type: schema.TypeString, Optional: true, ForceNew: true},
+		"widget":  {Type: schema.TypeString, Required: true, ForceNew: true},
+	}
+	d := schema.TestResourceDataRaw(t, parentFields, map[string]interface{}{})
+	d.SetId("projects/other-project/widgets/my-widget")
+
+	if err := genericIamIdParseFunc(GenericIamResource{PathTemplate: "projects/{project}/widgets/{widget}"})(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if d.Get("project").(string) != "other-project" || d.Get("widget").(string) != "my-widget" {
+		t.Fatalf("Unexpected fields project=%q widget=%q", d.Get("project"), d.Get("widget"))
+	}
+}
+
+// TestGenericIamResources checks the resource paths of the IAM resources built on the generic updater, and that
+// their import IDs are parsed back into the same resources.
+func TestGenericIamResources(t *testing.T) {
+	cases := map[string]struct {
+		schema          map[string]*schema.Schema
+		newUpdaterFunc  newResourceIamUpdaterFunc
+		idParseFunc     resourceIdParserFunc
+		raw             map[string]interface{}
+		expectedRequest string
+	}{
+		"workstations_workstation_config": {
+			schema:         IamWorkstationsWorkstationConfigSchema,
+			newUpdaterFunc: NewWorkstationsWorkstationConfigIamUpdater,
+			idParseFunc:    WorkstationsWorkstationConfigIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"cluster":  "my-cluster",
+				"config":   "my-config",
+			},
+			expectedRequest: "GET https://workstations.googleapis.com/v1/projects/my-project/locations/us-central1/workstationClusters/my-cluster/workstationConfigs/my-config:getIamPolicy",
+		},
+		"network_security_client_tls_policy": {
+			schema:         IamNetworkSecurityClientTlsPolicySchema,
+			newUpdaterFunc: NewNetworkSecurityClientTlsPolicyIamUpdater,
+			idParseFunc:    NetworkSecurityClientTlsPolicyIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://networksecurity.googleapis.com/v1/projects/my-project/locations/us-central1/clientTlsPolicies/my-name:getIamPolicy",
+		},
+		"batch_job": {
+			schema:         IamBatchJobSchema,
+			newUpdaterFunc: NewBatchJobIamUpdater,
+			idParseFunc:    BatchJobIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"job":      "my-job",
+			},
+			expectedRequest: "GET https://batch.googleapis.com/v1/projects/my-project/locations/us-central1/jobs/my-job:getIamPolicy",
+		},
+		"certificate_manager_certificate": {
+			schema:         IamCertificateManagerCertificateSchema,
+			newUpdaterFunc: NewCertificateManagerCertificateIamUpdater,
+			idParseFunc:    CertificateManagerCertificateIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://certificatemanager.googleapis.com/v1/projects/my-project/locations/us-central1/certificates/my-name:getIamPolicy",
+		},
+		"parallelstore_instance": {
+			schema:         IamParallelstoreInstanceSchema,
+			newUpdaterFunc: NewParallelstoreInstanceIamUpdater,
+			idParseFunc:    ParallelstoreInstanceIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"instance": "my-instance",
+			},
+			expectedRequest: "GET https://parallelstore.googleapis.com/v1/projects/my-project/locations/us-central1/instances/my-instance:getIamPolicy",
+		},
+		"beyondcorp_app_connector": {
+			schema:         IamBeyondcorpAppConnectorSchema,
+			newUpdaterFunc: NewBeyondcorpAppConnectorIamUpdater,
+			idParseFunc:    BeyondcorpAppConnectorIdParseFunc,
+			raw: map[string]interface{}{
+				"region": "us-central1",
+				"name":   "my-name",
+			},
+			expectedRequest: "GET https://beyondcorp.googleapis.com/v1/projects/my-project/locations/us-central1/appConnectors/my-name:getIamPolicy",
+		},
+		"managed_kafka_cluster": {
+			schema:         IamManagedKafkaClusterSchema,
+			newUpdaterFunc: NewManagedKafkaClusterIamUpdater,
+			idParseFunc:    ManagedKafkaClusterIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"cluster":  "my-cluster",
+			},
+			expectedRequest: "GET https://managedkafka.googleapis.com/v1/projects/my-project/locations/us-central1/clusters/my-cluster:getIamPolicy",
+		},
+		"gke_hub_scope": {
+			schema:         IamGkeHubScopeSchema,
+			newUpdaterFunc: NewGkeHubScopeIamUpdater,
+			idParseFunc:    GkeHubScopeIdParseFunc,
+			raw: map[string]interface{}{
+				"scope": "my-scope",
+			},
+			expectedRequest: "GET https://gkehub.googleapis.com/v1/projects/my-project/locations/global/scopes/my-scope:getIamPolicy",
+		},
+		"dataproc_metastore_service": {
+			schema:         IamDataprocMetastoreServiceSchema,
+			newUpdaterFunc: NewDataprocMetastoreServiceIamUpdater,
+			idParseFunc:    DataprocMetastoreServiceIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"service":  "my-service",
+			},
+			expectedRequest: "GET https://metastore.googleapis.com/v1/projects/my-project/locations/us-central1/services/my-service:getIamPolicy",
+		},
+		"gke_hub_feature": {
+			schema:         IamGkeHubFeatureSchema,
+			newUpdaterFunc: NewGkeHubFeatureIamUpdater,
+			idParseFunc:    GkeHubFeatureIdParseFunc,
+			raw: map[string]interface{}{
+				"feature": "my-feature",
+			},
+			expectedRequest: "GET https://gkehub.googleapis.com/v1/projects/my-project/locations/global/features/my-feature:getIamPolicy",
+		},
+		"vmwareengine_private_cloud": {
+			schema:         IamVmwareenginePrivateCloudSchema,
+			newUpdaterFunc: NewVmwareenginePrivateCloudIamUpdater,
+			idParseFunc:    VmwareenginePrivateCloudIdParseFunc,
+			raw: map[string]interface{}{
+				"location":      "us-central1",
+				"private_cloud": "my-private-cloud",
+			},
+			expectedRequest: "GET https://vmwareengine.googleapis.com/v1/projects/my-project/locations/us-central1/privateClouds/my-private-cloud:getIamPolicy",
+		},
+		"vertex_ai_metadata_store": {
+			schema:         IamVertexAIMetadataStoreSchema,
+			newUpdaterFunc: NewVertexAIMetadataStoreIamUpdater,
+			idParseFunc:    VertexAIMetadataStoreIdParseFunc,
+			raw: map[string]interface{}{
+				"region":         "us-central1",
+				"metadata_store": "my-metadata-store",
+			},
+			expectedRequest: "POST https://us-central1-aiplatform.googleapis.com/v1/projects/my-project/locations/us-central1/metadataStores/my-metadata-store:getIamPolicy",
+		},
+		"developer_connect_connection": {
+			schema:         IamDeveloperConnectConnectionSchema,
+			newUpdaterFunc: NewDeveloperConnectConnectionIamUpdater,
+			idParseFunc:    DeveloperConnectConnectionIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://developerconnect.googleapis.com/v1/projects/my-project/locations/us-central1/connections/my-name:getIamPolicy",
+		},
+		"apphub_application": {
+			schema:         IamAppHubApplicationSchema,
+			newUpdaterFunc: NewAppHubApplicationIamUpdater,
+			idParseFunc:    AppHubApplicationIdParseFunc,
+			raw: map[string]interface{}{
+				"location":    "us-central1",
+				"application": "my-application",
+			},
+			expectedRequest: "GET https://apphub.googleapis.com/v1/projects/my-project/locations/us-central1/applications/my-application:getIamPolicy",
+		},
+		"assured_workloads_workload": {
+			schema:         IamAssuredWorkloadsWorkloadSchema,
+			newUpdaterFunc: NewAssuredWorkloadsWorkloadIamUpdater,
+			idParseFunc:    AssuredWorkloadsWorkloadIdParseFunc,
+			raw: map[string]interface{}{
+				"location":     "us-central1",
+				"organization": "my-organization",
+				"workload":     "my-workload",
+			},
+			expectedRequest: "GET https://us-central1-assuredworkloads.googleapis.com/v1/organizations/my-organization/locations/us-central1/workloads/my-workload:getIamPolicy",
+		},
+		"discovery_engine_engine": {
+			schema:         IamDiscoveryEngineEngineSchema,
+			newUpdaterFunc: NewDiscoveryEngineEngineIamUpdater,
+			idParseFunc:    DiscoveryEngineEngineIdParseFunc,
+			raw: map[string]interface{}{
+				"engine": "my-engine",
+			},
+			expectedRequest: "GET https://discoveryengine.googleapis.com/v1/projects/my-project/locations/global/collections/default_collection/engines/my-engine:getIamPolicy",
+		},
+		"oracle_database_cloud_exadata_infrastructure": {
+			schema:         IamOracleDatabaseCloudExadataInfrastructureSchema,
+			newUpdaterFunc: NewOracleDatabaseCloudExadataInfrastructureIamUpdater,
+			idParseFunc:    OracleDatabaseCloudExadataInfrastructureIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://oracledatabase.googleapis.com/v1/projects/my-project/locations/us-central1/cloudExadataInfrastructures/my-name:getIamPolicy",
+		},
+		"compute_reservation": {
+			schema:         IamComputeReservationSchema,
+			newUpdaterFunc: NewComputeReservationIamUpdater,
+			idParseFunc:    ComputeReservationIdParseFunc,
+			raw: map[string]interface{}{
+				"zone":        "us-central1-a",
+				"reservation": "my-reservation",
+			},
+			expectedRequest: "GET https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/reservations/my-reservation/getIamPolicy",
+		},
+		"data_fusion_instance": {
+			schema:         IamDataFusionInstanceSchema,
+			newUpdaterFunc: NewDataFusionInstanceIamUpdater,
+			idParseFunc:    DataFusionInstanceIdParseFunc,
+			raw: map[string]interface{}{
+				"region":   "us-central1",
+				"instance": "my-instance",
+			},
+			expectedRequest: "GET https://datafusion.googleapis.com/v1/projects/my-project/locations/us-central1/instances/my-instance:getIamPolicy",
+		},
+		"apigee_organization": {
+			schema:         IamApigeeOrganizationSchema,
+			newUpdaterFunc: NewApigeeOrganizationIamUpdater,
+			idParseFunc:    ApigeeOrganizationIdParseFunc,
+			raw: map[string]interface{}{
+				"org_id": "my-org-id",
+			},
+			expectedRequest: "GET https://apigee.googleapis.com/v1/organizations/my-org-id:getIamPolicy",
+		},
+		"firestore_database": {
+			schema:          IamFirestoreDatabaseSchema,
+			newUpdaterFunc:  NewFirestoreDatabaseIamUpdater,
+			idParseFunc:     FirestoreDatabaseIdParseFunc,
+			raw:             map[string]interface{}{},
+			expectedRequest: "GET https://firestore.googleapis.com/v1/projects/my-project/databases/(default):getIamPolicy",
+		},
+		"gemini_gemini_gcp_enablement_setting": {
+			schema:         IamGeminiGcpEnablementSettingSchema,
+			newUpdaterFunc: NewGeminiGcpEnablementSettingIamUpdater,
+			idParseFunc:    GeminiGcpEnablementSettingIdParseFunc,
+			raw: map[string]interface{}{
+				"setting": "my-setting",
+			},
+			expectedRequest: "GET https://cloudaicompanion.googleapis.com/v1/projects/my-project/locations/global/geminiGcpEnablementSettings/my-setting:getIamPolicy",
+		},
+		"network_connectivity_hub": {
+			schema:         IamNetworkConnectivityHubSchema,
+			newUpdaterFunc: NewNetworkConnectivityHubIamUpdater,
+			idParseFunc:    NetworkConnectivityHubIdParseFunc,
+			raw: map[string]interface{}{
+				"hub": "my-hub",
+			},
+			expectedRequest: "GET https://networkconnectivity.googleapis.com/v1/projects/my-project/locations/global/hubs/my-hub:getIamPolicy",
+		},
+		"gke_backup_restore_plan": {
+			schema:         IamGkeBackupRestorePlanSchema,
+			newUpdaterFunc: NewGkeBackupRestorePlanIamUpdater,
+			idParseFunc:    GkeBackupRestorePlanIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://gkebackup.googleapis.com/v1/projects/my-project/locations/us-central1/restorePlans/my-name:getIamPolicy",
+		},
+		"vertex_ai_index_endpoint": {
+			schema:         IamVertexAIIndexEndpointSchema,
+			newUpdaterFunc: NewVertexAIIndexEndpointIamUpdater,
+			idParseFunc:    VertexAIIndexEndpointIdParseFunc,
+			raw: map[string]interface{}{
+				"region":         "us-central1",
+				"index_endpoint": "my-index-endpoint",
+			},
+			expectedRequest: "POST https://us-central1-aiplatform.googleapis.com/v1/projects/my-project/locations/us-central1/indexEndpoints/my-index-endpoint:getIamPolicy",
+		},
+		"vertex_ai_tensorboard": {
+			schema:         IamVertexAITensorboardSchema,
+			newUpdaterFunc: NewVertexAITensorboardIamUpdater,
+			idParseFunc:    VertexAITensorboardIdParseFunc,
+			raw: map[string]interface{}{
+				"region":      "us-central1",
+				"tensorboard": "my-tensorboard",
+			},
+			expectedRequest: "POST https://us-central1-aiplatform.googleapis.com/v1/projects/my-project/locations/us-central1/tensorboards/my-tensorboard:getIamPolicy",
+		},
+		"alloydb_cluster": {
+			schema:         IamAlloyDBClusterSchema,
+			newUpdaterFunc: NewAlloyDBClusterIamUpdater,
+			idParseFunc:    AlloyDBClusterIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"cluster":  "my-cluster",
+			},
+			expectedRequest: "GET https://alloydb.googleapis.com/v1/projects/my-project/locations/us-central1/clusters/my-cluster:getIamPolicy",
+		},
+		"cloudbuild_worker_pool": {
+			schema:         IamCloudBuildWorkerPoolSchema,
+			newUpdaterFunc: NewCloudBuildWorkerPoolIamUpdater,
+			idParseFunc:    CloudBuildWorkerPoolIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://cloudbuild.googleapis.com/v1/projects/my-project/locations/us-central1/workerPools/my-name:getIamPolicy",
+		},
+		"clouddeploy_target": {
+			schema:         IamCloudDeployTargetSchema,
+			newUpdaterFunc: NewCloudDeployTargetIamUpdater,
+			idParseFunc:    CloudDeployTargetIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"target":   "my-target",
+			},
+			expectedRequest: "GET https://clouddeploy.googleapis.com/v1/projects/my-project/locations/us-central1/targets/my-target:getIamPolicy",
+		},
+		"dataform_repository": {
+			schema:         IamDataformRepositorySchema,
+			newUpdaterFunc: NewDataformRepositoryIamUpdater,
+			idParseFunc:    DataformRepositoryIdParseFunc,
+			raw: map[string]interface{}{
+				"region":     "us-central1",
+				"repository": "my-repository",
+			},
+			expectedRequest: "GET https://dataform.googleapis.com/v1beta1/projects/my-project/locations/us-central1/repositories/my-repository:getIamPolicy",
+		},
+		"discovery_engine_data_store": {
+			schema:         IamDiscoveryEngineDataStoreSchema,
+			newUpdaterFunc: NewDiscoveryEngineDataStoreIamUpdater,
+			idParseFunc:    DiscoveryEngineDataStoreIdParseFunc,
+			raw: map[string]interface{}{
+				"data_store": "my-data-store",
+			},
+			expectedRequest: "GET https://discoveryengine.googleapis.com/v1/projects/my-project/locations/global/collections/default_collection/dataStores/my-data-store:getIamPolicy",
+		},
+		"edgecontainer_cluster": {
+			schema:         IamEdgeContainerClusterSchema,
+			newUpdaterFunc: NewEdgeContainerClusterIamUpdater,
+			idParseFunc:    EdgeContainerClusterIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"cluster":  "my-cluster",
+			},
+			expectedRequest: "GET https://edgecontainer.googleapis.com/v1/projects/my-project/locations/us-central1/clusters/my-cluster:getIamPolicy",
+		},
+		"eventarc_channel": {
+			schema:         IamEventarcChannelSchema,
+			newUpdaterFunc: NewEventarcChannelIamUpdater,
+			idParseFunc:    EventarcChannelIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"channel":  "my-channel",
+			},
+			expectedRequest: "GET https://eventarc.googleapis.com/v1/projects/my-project/locations/us-central1/channels/my-channel:getIamPolicy",
+		},
+		"integration_connectors_connection": {
+			schema:         IamIntegrationConnectorsConnectionSchema,
+			newUpdaterFunc: NewIntegrationConnectorsConnectionIamUpdater,
+			idParseFunc:    IntegrationConnectorsConnectionIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://connectors.googleapis.com/v1/projects/my-project/locations/us-central1/connections/my-name:getIamPolicy",
+		},
+		"pubsub_lite_reservation": {
+			schema:         IamPubsubLiteReservationSchema,
+			newUpdaterFunc: NewPubsubLiteReservationIamUpdater,
+			idParseFunc:    PubsubLiteReservationIdParseFunc,
+			raw: map[string]interface{}{
+				"region":      "us-central1",
+				"reservation": "my-reservation",
+			},
+			expectedRequest: "GET https://pubsublite.googleapis.com/v1/admin/projects/my-project/locations/us-central1/reservations/my-reservation:getIamPolicy",
+		},
+		"pubsub_lite_topic": {
+			schema:         IamPubsubLiteTopicSchema,
+			newUpdaterFunc: NewPubsubLiteTopicIamUpdater,
+			idParseFunc:    PubsubLiteTopicIdParseFunc,
+			raw: map[string]interface{}{
+				"region": "us-central1",
+				"topic":  "my-topic",
+			},
+			expectedRequest: "GET https://pubsublite.googleapis.com/v1/admin/projects/my-project/locations/us-central1/topics/my-topic:getIamPolicy",
+		},
+		"redis_instance": {
+			schema:         IamRedisInstanceSchema,
+			newUpdaterFunc: NewRedisInstanceIamUpdater,
+			idParseFunc:    RedisInstanceIdParseFunc,
+			raw: map[string]interface{}{
+				"region":   "us-central1",
+				"instance": "my-instance",
+			},
+			expectedRequest: "GET https://redis.googleapis.com/v1/projects/my-project/locations/us-central1/instances/my-instance:getIamPolicy",
+		},
+		"service_directory_namespace": {
+			schema:         IamServiceDirectoryNamespaceSchema,
+			newUpdaterFunc: NewServiceDirectoryNamespaceIamUpdater,
+			idParseFunc:    ServiceDirectoryNamespaceIdParseFunc,
+			raw: map[string]interface{}{
+				"location":  "us-central1",
+				"namespace": "my-namespace",
+			},
+			expectedRequest: "POST https://servicedirectory.googleapis.com/v1/projects/my-project/locations/us-central1/namespaces/my-namespace:getIamPolicy",
+		},
+		"compute_machine_image": {
+			schema:         IamComputeMachineImageSchema,
+			newUpdaterFunc: NewComputeMachineImageIamUpdater,
+			idParseFunc:    ComputeMachineImageIdParseFunc,
+			raw: map[string]interface{}{
+				"machine_image": "my-machine-image",
+			},
+			expectedRequest: "GET https://www.googleapis.com/compute/v1/projects/my-project/global/machineImages/my-machine-image/getIamPolicy",
+		},
+		"compute_snapshot": {
+			schema:         IamComputeSnapshotSchema,
+			newUpdaterFunc: NewComputeSnapshotIamUpdater,
+			idParseFunc:    ComputeSnapshotIdParseFunc,
+			raw: map[string]interface{}{
+				"snapshot": "my-snapshot",
+			},
+			expectedRequest: "GET https://www.googleapis.com/compute/v1/projects/my-project/global/snapshots/my-snapshot/getIamPolicy",
+		},
+		"scc_v2_organization_source": {
+			schema:         IamSecurityCenterV2FindingSourceSchema,
+			newUpdaterFunc: NewSecurityCenterV2FindingSourceIamUpdater,
+			idParseFunc:    SecurityCenterV2FindingSourceIdParseFunc,
+			raw: map[string]interface{}{
+				"organization": "my-organization",
+				"source":       "my-source",
+			},
+			expectedRequest: "POST https://securitycenter.googleapis.com/v2/organizations/my-organization/locations/global/sources/my-source:getIamPolicy",
+		},
+		"iam_workload_identity_pool": {
+			schema:         IamWorkloadIdentityPoolSchema,
+			newUpdaterFunc: NewIamWorkloadIdentityPoolIamUpdater,
+			idParseFunc:    IamWorkloadIdentityPoolIdParseFunc,
+			raw: map[string]interface{}{
+				"workload_identity_pool_id": "my-workload-identity-pool-id",
+			},
+			expectedRequest: "POST https://iam.googleapis.com/v1/projects/my-project/locations/global/workloadIdentityPools/my-workload-identity-pool-id:getIamPolicy",
+		},
+		"container_cluster": {
+			schema:         IamContainerClusterSchema,
+			newUpdaterFunc: NewContainerClusterIamUpdater,
+			idParseFunc:    ContainerClusterIdParseFunc,
+			raw: map[string]interface{}{
+				"cluster": "my-cluster",
+			},
+			expectedRequest: "GET https://gkehub.googleapis.com/v1/projects/my-project/locations/global/memberships/my-cluster:getIamPolicy",
+		},
+		"gke_multi_cloud_attached_cluster": {
+			schema:         IamGkeMultiCloudAttachedClusterSchema,
+			newUpdaterFunc: NewGkeMultiCloudAttachedClusterIamUpdater,
+			idParseFunc:    GkeMultiCloudAttachedClusterIdParseFunc,
+			raw: map[string]interface{}{
+				"location": "us-central1",
+				"name":     "my-name",
+			},
+			expectedRequest: "GET https://us-central1-gkemulticloud.googleapis.com/v1/projects/my-project/locations/us-central1/attachedClusters/my-name:getIamPolicy",
+		},
+	}
+
+	for name, tc := range cases {
+		var requests []string
+		config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+			requests = append(requests, req.Method+" "+req.URL.String())
+			return testResponse(200, `{"etag":"BwVZ1Q=="}`), nil
+		})
+		config.Region = "us-central1"
+
+		d := schema.TestResourceDataRaw(t, tc.schema, tc.raw)
+		updater, err := tc.newUpdaterFunc(d, config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		if _, err := updater.GetResourceIamPolicy(); err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		if len(requests) != 1 || requests[0] != tc.expectedRequest {
+			t.Fatalf("%s: expected request %q, got %v", name, tc.expectedRequest, requests)
+		}
+		if updater.GetResourceDescriptor().ResourceType != name {
+			t.Fatalf("%s: expected resource type %q, got %q", name, name, updater.GetResourceDescriptor().ResourceType)
+		}
+
+		imported := schema.TestResourceDataRaw(t, tc.schema, map[string]interface{}{})
+		imported.SetId(updater.GetResourceId())
+		if err := tc.idParseFunc(imported, config); err != nil {
+			t.Fatalf("%s: unexpected error parsing %q: %s", name, updater.GetResourceId(), err)
+		}
+		importedUpdater, err := tc.newUpdaterFunc(imported, config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		if importedUpdater.GetResourceId() != updater.GetResourceId() {
+			t.Fatalf("%s: expected import ID %q to be parsed back into the same resource, got %q", name, updater.GetResourceId(), importedUpdater.GetResourceId())
+		}
+	}
+}
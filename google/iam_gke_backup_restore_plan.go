@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamGkeBackupRestorePlanSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var gkeBackupRestorePlanIamResource = GenericIamResource{
+	Type:         "gke_backup_restore_plan",
+	Description:  "GKE Backup restore plan",
+	Api:          "gke_backup",
+	PathTemplate: "projects/{project}/locations/{location}/restorePlans/{name}",
+}
+
+var (
+	NewGkeBackupRestorePlanIamUpdater = NewGenericResourceIamUpdater(gkeBackupRestorePlanIamResource, IamGkeBackupRestorePlanSchema)
+	GkeBackupRestorePlanIdParseFunc   = genericIamIdParseFunc(gkeBackupRestorePlanIamResource)
+)
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamGkeHubFeatureSchema = map[string]*schema.Schema{
+	"feature": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var gkeHubFeatureIamResource = GenericIamResource{
+	Type:         "gke_hub_feature",
+	Description:  "GKE Hub feature",
+	Api:          "gke_hub",
+	PathTemplate: "projects/{project}/locations/{location}/features/{feature}",
+}
+
+var (
+	NewGkeHubFeatureIamUpdater = NewGenericResourceIamUpdater(gkeHubFeatureIamResource, IamGkeHubFeatureSchema)
+	GkeHubFeatureIdParseFunc   = genericIamIdParseFunc(gkeHubFeatureIamResource)
+)
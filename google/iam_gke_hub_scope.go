@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamGkeHubScopeSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"scope": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var gkeHubScopeIamResource = GenericIamResource{
+	Type:         "gke_hub_scope",
+	Description:  "GKE Hub scope",
+	Api:          "gke_hub",
+	PathTemplate: "projects/{project}/locations/{location}/scopes/{scope}",
+}
+
+var (
+	NewGkeHubScopeIamUpdater = NewGenericResourceIamUpdater(gkeHubScopeIamResource, IamGkeHubScopeSchema)
+	GkeHubScopeIdParseFunc   = genericIamIdParseFunc(gkeHubScopeIamResource)
+)
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamGkeMultiCloudAttachedClusterSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var gkeMultiCloudAttachedClusterIamResource = GenericIamResource{
+	Type:         "gke_multi_cloud_attached_cluster",
+	Description:  "attached cluster",
+	Api:          "container_attached",
+	PathTemplate: "projects/{project}/locations/{location}/attachedClusters/{name}",
+}
+
+var (
+	NewGkeMultiCloudAttachedClusterIamUpdater = NewGenericResourceIamUpdater(gkeMultiCloudAttachedClusterIamResource, IamGkeMultiCloudAttachedClusterSchema)
+	GkeMultiCloudAttachedClusterIdParseFunc   = genericIamIdParseFunc(gkeMultiCloudAttachedClusterIamResource)
+)
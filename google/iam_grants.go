@@ -0,0 +1,178 @@
+package google
+
+import (
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"log"
+	"sort"
+	"sync"
+)
+
+// iamGrantRegistry records the roles granted to members on folders and projects by the IAM binding, member and
+// policy resources, e.g. `google_folder_iam_policy` and `google_project_iam_binding`, to report the grants made
+// both on a folder and on a project within it. As policies are inherited, the grant on the project is redundant.
+type iamGrantRegistry struct {
+	mu sync.Mutex
+
+	// The folders and projects each grant was made on, by grant, i.e. `{role} {member}`.
+	folders  map[string]map[string]bool
+	projects map[string]map[string]bool
+
+	// projectFolders returns the folders containing a project, e.g. `folders/123`.
+	projectFolders func(project string) ([]string, error)
+	ancestry       map[string][]string
+
+	reported map[string]bool
+}
+
+func newIamGrantRegistry(config *Config) *iamGrantRegistry {
+	return &iamGrantRegistry{
+		folders:  make(map[string]map[string]bool),
+		projects: make(map[string]map[string]bool),
+		ancestry: make(map[string][]string),
+		reported: make(map[string]bool),
+		projectFolders: func(project string) ([]string, error) {
+			resp, err := config.clientResourceManager.Projects.GetAncestry(project, &cloudresourcemanager.GetAncestryRequest{}).Do()
+			if err != nil {
+				return nil, err
+			}
+
+			var folders []string
+			for _, a := range resp.Ancestor {
+				if a.ResourceId != nil && a.ResourceId.Type == "folder" {
+					folders = append(folders, "folders/"+a.ResourceId.Id)
+				}
+			}
+			return folders, nil
+		},
+	}
+}
+
+// recordIamGrants records the grants of role to members on the resource of updater when the redundant grants are
+// reported, i.e. `report_redundant_iam_grants` is set, and logs those made on both a folder and a project within it.
+// Only the grants on folders and projects are recorded.
+func recordIamGrants(config *Config, updater ResourceIamUpdater, role string, members []string) {
+	if config.iamGrants == nil {
+		return
+	}
+
+	desc := updater.GetResourceDescriptor()
+	switch desc.ResourceType {
+	case "folder":
+		config.iamGrants.record(config.iamGrants.folders, desc.ResourceId, role, members)
+	case "project":
+		config.iamGrants.record(config.iamGrants.projects, desc.ResourceId, role, members)
+	default:
+		return
+	}
+
+	for _, g := range config.iamGrants.redundantGrants(role, members) {
+		log.Printf("[INFO] Role %q is granted to %q on both %s and project %q within it. The grant on the project is redundant, as it inherits the policy of the folder.",
+			role, logIamMember(config, g.member), g.folder, g.project)
+	}
+}
+
+func (r *iamGrantRegistry) record(grants map[string]map[string]bool, resourceId, role string, members []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, member := range members {
+		grant := role + " " + member
+		if grants[grant] == nil {
+			grants[grant] = make(map[string]bool)
+		}
+		grants[grant][resourceId] = true
+	}
+}
+
+// A redundantIamGrant is a grant of a role to member made on both folder and project within it.
+type redundantIamGrant struct {
+	member  string
+	folder  string
+	project string
+}
+
+// redundantGrants returns the grants of role to one of members made on both a folder and a project within it,
+// which weren't returned before.
+func (r *iamGrantRegistry) redundantGrants(role string, members []string) []redundantIamGrant {
+	// The grants are copied out under the lock, which isn't held while the folders of the projects are retrieved.
+	type recordedGrant struct {
+		member   string
+		folders  map[string]bool
+		projects []string
+	}
+	var grants []recordedGrant
+	r.mu.Lock()
+	for _, member := range members {
+		grant := role + " " + member
+		if len(r.folders[grant]) == 0 {
+			continue
+		}
+
+		folders := make(map[string]bool, len(r.folders[grant]))
+		for folder := range r.folders[grant] {
+			folders[folder] = true
+		}
+		grants = append(grants, recordedGrant{member: member, folders: folders, projects: sortedKeys(r.projects[grant])})
+	}
+	r.mu.Unlock()
+
+	var redundant []redundantIamGrant
+	for _, g := range grants {
+		for _, project := range g.projects {
+			folders, err := r.getProjectFolders(project)
+			if err != nil {
+				log.Printf("[WARN] Couldn't retrieve the folders containing project %q, its grants may be redundant with those of its folders: %s", project, err)
+				continue
+			}
+
+			for _, folder := range folders {
+				if g.folders[folder] && r.markReported(role+" "+g.member+" "+folder+" "+project) {
+					redundant = append(redundant, redundantIamGrant{member: g.member, folder: folder, project: project})
+				}
+			}
+		}
+	}
+
+	return redundant
+}
+
+// markReported records that the redundant grant key was reported, and returns whether it wasn't before.
+func (r *iamGrantRegistry) markReported(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.reported[key] {
+		return false
+	}
+	r.reported[key] = true
+	return true
+}
+
+// getProjectFolders returns the folders containing project, which are only retrieved once.
+func (r *iamGrantRegistry) getProjectFolders(project string) ([]string, error) {
+	r.mu.Lock()
+	folders, ok := r.ancestry[project]
+	r.mu.Unlock()
+	if ok {
+		return folders, nil
+	}
+
+	folders, err := r.projectFolders(project)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ancestry[project] = folders
+	return folders, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,174 @@
+package google
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
+)
+
+// testHierarchyIamUpdater is a testIamUpdater describing itself as a folder or a project.
+type testHierarchyIamUpdater struct {
+	*testIamUpdater
+	resourceType string
+	resourceId   string
+}
+
+func (u *testHierarchyIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return IamResourceDescriptor{
+		ResourceType: u.resourceType,
+		ResourceId:   u.resourceId,
+	}
+}
+
+func newTestHierarchyIamUpdater(resourceType, resourceId string) newResourceIamUpdaterFunc {
+	u := &testHierarchyIamUpdater{
+		testIamUpdater: newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com"},
+		}),
+		resourceType: resourceType,
+		resourceId:   resourceId,
+	}
+	return func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		return u, nil
+	}
+}
+
+func TestRecordIamGrants_folderAndProject(t *testing.T) {
+	config := &Config{}
+	config.iamGrants = newIamGrantRegistry(config)
+	config.iamGrants.projectFolders = func(project string) ([]string, error) {
+		if project == "in-folder" {
+			return []string{"folders/123", "folders/456"}, nil
+		}
+		return []string{"folders/789"}, nil
+	}
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	read := func(resourceType, resourceId string) {
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":    "roles/viewer",
+			"members": []interface{}{"user:admin@example.com"},
+		})
+		d.SetId(resourceId + "/roles/viewer")
+		if err := resourceIamBindingRead(newTestHierarchyIamUpdater(resourceType, resourceId))(d, config); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	read("project", "in-folder")
+	read("project", "outside-folder")
+	read("folder", "folders/456")
+	// The overlap was already reported.
+	read("project", "in-folder")
+
+	expected := `Role "roles/viewer" is granted to "user:admin@example.com" on both folders/456 and project "in-folder" within it.`
+	if n := strings.Count(buf.String(), expected); n != 1 {
+		t.Fatalf("Expected the log to contain %q once, got %d times: %s", expected, n, buf.String())
+	}
+	if strings.Contains(buf.String(), "outside-folder\" within it") {
+		t.Fatalf("Expected no redundant grant to be reported for the project outside the folder, got %s", buf.String())
+	}
+}
+
+func TestRecordIamGrants_folderPolicyAndProjectBinding(t *testing.T) {
+	client := &http.Client{Transport: testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/projects/in-folder:getAncestry":
+			return testResponse(200, `{"ancestor":[{"resourceId":{"type":"project","id":"in-folder"}},{"resourceId":{"type":"folder","id":"123"}},{"resourceId":{"type":"organization","id":"456"}}]}`), nil
+		case "/v2beta1/folders/123:getIamPolicy", "/v1/projects/in-folder:getIamPolicy":
+			return testResponse(200, `{"etag":"BwV=","bindings":[{"role":"roles/viewer","members":["user:admin@example.com"]}]}`), nil
+		}
+		return testResponse(404, `{"error":{"code":404,"message":"Not found"}}`), nil
+	})}
+	config := &Config{ReportRedundantIamGrants: true}
+	config.clientResourceManager, _ = cloudresourcemanager.New(client)
+	config.clientResourceManagerV2Beta1, _ = resourceManagerV2Beta1.New(client)
+	config.iamGrants = newIamGrantRegistry(config)
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	resources := Provider().(*schema.Provider).ResourcesMap
+	folder := resources["google_folder_iam_policy"]
+	d := schema.TestResourceDataRaw(t, folder.Schema, map[string]interface{}{
+		"folder":      "folders/123",
+		"policy_data": `{"bindings":[{"role":"roles/viewer","members":["user:admin@example.com"]}]}`,
+	})
+	d.SetId("folders/123")
+	if err := folder.Read(d, config); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	project := resources["google_project_iam_binding"]
+	d = schema.TestResourceDataRaw(t, project.Schema, map[string]interface{}{
+		"project": "in-folder",
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+	d.SetId("in-folder/roles/viewer")
+	if err := project.Read(d, config); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := `Role "roles/viewer" is granted to "user:admin@example.com" on both folders/123 and project "in-folder" within it.`
+	if !strings.Contains(buf.String(), expected) {
+		t.Fatalf("Expected the log to contain %q, got %s", expected, buf.String())
+	}
+}
+
+func TestRecordIamGrants_disabled(t *testing.T) {
+	config := &Config{}
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	for _, r := range [][]string{{"folder", "folders/123"}, {"project", "in-folder"}} {
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":    "roles/viewer",
+			"members": []interface{}{"user:admin@example.com"},
+		})
+		d.SetId(r[1] + "/roles/viewer")
+		if err := resourceIamBindingRead(newTestHierarchyIamUpdater(r[0], r[1]))(d, config); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	if strings.Contains(buf.String(), "is redundant") {
+		t.Fatalf("Expected no redundant grant to be reported, got %s", buf.String())
+	}
+}
+
+func TestRecordIamGrants_unlockedAncestry(t *testing.T) {
+	config := &Config{}
+	config.iamGrants = newIamGrantRegistry(config)
+	config.iamGrants.projectFolders = func(project string) ([]string, error) {
+		// The registry isn't locked while the folders are retrieved, so other grants can be recorded meanwhile.
+		if !config.iamGrants.mu.TryLock() {
+			t.Fatalf("Expected the registry not to be locked while retrieving the folders of %q", project)
+		}
+		config.iamGrants.mu.Unlock()
+		return []string{"folders/123"}, nil
+	}
+
+	config.iamGrants.record(config.iamGrants.folders, "folders/123", "roles/viewer", []string{"user:admin@example.com"})
+	config.iamGrants.record(config.iamGrants.projects, "in-folder", "roles/viewer", []string{"user:admin@example.com"})
+	if redundant := config.iamGrants.redundantGrants("roles/viewer", []string{"user:admin@example.com"}); len(redundant) != 1 {
+		t.Fatalf("Expected the grant on the project to be redundant, got %v", redundant)
+	}
+	if redundant := config.iamGrants.redundantGrants("roles/viewer", []string{"user:admin@example.com"}); len(redundant) != 0 {
+		t.Fatalf("Expected the redundant grant to be reported once, got %v", redundant)
+	}
+}
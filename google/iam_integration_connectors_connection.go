@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamIntegrationConnectorsConnectionSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var integrationConnectorsConnectionIamResource = GenericIamResource{
+	Type:         "integration_connectors_connection",
+	Description:  "Integration Connectors connection",
+	Api:          "integration_connectors",
+	PathTemplate: "projects/{project}/locations/{location}/connections/{name}",
+}
+
+var (
+	NewIntegrationConnectorsConnectionIamUpdater = NewGenericResourceIamUpdater(integrationConnectorsConnectionIamResource, IamIntegrationConnectorsConnectionSchema)
+	IntegrationConnectorsConnectionIdParseFunc   = genericIamIdParseFunc(integrationConnectorsConnectionIamResource)
+)
@@ -0,0 +1,130 @@
+package google
+
+import (
+	"fmt"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamKmsKeyRingSchema = map[string]*schema.Schema{
+	"key_ring_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type KmsKeyRingIamUpdater struct {
+	resourceId string
+	Config     *Config
+}
+
+func NewKmsKeyRingIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	keyRingId, err := parseKmsKeyRingId(d.Get("key_ring_id").(string), config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KmsKeyRingIamUpdater{
+		resourceId: keyRingId.keyRingId(),
+		Config:     config,
+	}, nil
+}
+
+func (u *KmsKeyRingIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	p, err := u.Config.clientKms.Projects.Locations.KeyRings.GetIamPolicy(u.resourceId).Do()
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	// The conditions of the bindings would be lost on the next write, as they can't be represented.
+	for _, b := range p.Bindings {
+		if b.Condition != nil {
+			return nil, fmt.Errorf("The IAM policy for %s has a conditional binding for role %q, which isn't supported", u.DescribeResource(), b.Role)
+		}
+	}
+
+	v1Policy, err := kmsPolicyToResourceManager(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1Policy, nil
+}
+
+func (u *KmsKeyRingIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	kmsPolicy, err := resourceManagerPolicyToKms(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := u.Config.clientKms.Projects.Locations.KeyRings.SetIamPolicy(u.resourceId, &cloudkms.SetIamPolicyRequest{
+		Policy: kmsPolicy,
+	}).Do()
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	v1Policy, err := kmsPolicyToResourceManager(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1Policy, nil
+}
+
+func (u *KmsKeyRingIamUpdater) CheckParentExists() error {
+	_, err := u.Config.clientKms.Projects.Locations.KeyRings.Get(u.resourceId).Fields("name").Do()
+
+	return iamParentError(u, err)
+}
+
+func (u *KmsKeyRingIamUpdater) GetResourceId() string {
+	return u.resourceId
+}
+
+func (u *KmsKeyRingIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-kms-key-ring-%s", u.resourceId)
+}
+
+func (u *KmsKeyRingIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("KMS key ring %q", u.resourceId)
+}
+
+func (u *KmsKeyRingIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return newRestIamResourceDescriptor("kms_key_ring", u.resourceId)
+}
+
+func KmsKeyRingIdParseFunc(d *schema.ResourceData, config *Config) error {
+	keyRingId, err := parseKmsKeyRingId(d.Id(), config)
+	if err != nil {
+		return err
+	}
+
+	d.Set("key_ring_id", keyRingId.terraformId())
+	return nil
+}
+
+// The cloudkms and cloudresourcemanager v1 policies are identical, except for the conditions
+// of the bindings which cloudresourcemanager v1 doesn't have.
+func resourceManagerPolicyToKms(in *cloudresourcemanager.Policy) (*cloudkms.Policy, error) {
+	out := &cloudkms.Policy{}
+	err := Convert(in, out)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot convert a v1 policy to a cloudkms policy: %s", err)
+	}
+	return out, nil
+}
+
+func kmsPolicyToResourceManager(in *cloudkms.Policy) (*cloudresourcemanager.Policy, error) {
+	out := &cloudresourcemanager.Policy{}
+	err := Convert(in, out)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot convert a cloudkms policy to a v1 policy: %s", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamManagedKafkaClusterSchema = map[string]*schema.Schema{
+	"cluster": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var managedKafkaClusterIamResource = GenericIamResource{
+	Type:         "managed_kafka_cluster",
+	Description:  "Managed Kafka cluster",
+	Api:          "managed_kafka",
+	PathTemplate: "projects/{project}/locations/{location}/clusters/{cluster}",
+}
+
+var (
+	NewManagedKafkaClusterIamUpdater = NewGenericResourceIamUpdater(managedKafkaClusterIamResource, IamManagedKafkaClusterSchema)
+	ManagedKafkaClusterIdParseFunc   = genericIamIdParseFunc(managedKafkaClusterIamResource)
+)
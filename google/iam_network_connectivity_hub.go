@@ -0,0 +1,28 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamNetworkConnectivityHubSchema = map[string]*schema.Schema{
+	"hub": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var networkConnectivityHubIamResource = GenericIamResource{
+	Type:         "network_connectivity_hub",
+	Description:  "Network Connectivity hub",
+	Api:          "network_connectivity",
+	PathTemplate: "projects/{project}/locations/global/hubs/{hub}",
+}
+
+var (
+	NewNetworkConnectivityHubIamUpdater = NewGenericResourceIamUpdater(networkConnectivityHubIamResource, IamNetworkConnectivityHubSchema)
+	NetworkConnectivityHubIdParseFunc   = genericIamIdParseFunc(networkConnectivityHubIamResource)
+)
@@ -0,0 +1,33 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamNetworkSecurityClientTlsPolicySchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var networkSecurityClientTlsPolicyIamResource = GenericIamResource{
+	Type:         "network_security_client_tls_policy",
+	Description:  "Network Security client TLS policy",
+	Api:          "network_security",
+	PathTemplate: "projects/{project}/locations/{location}/clientTlsPolicies/{name}",
+}
+
+var (
+	NewNetworkSecurityClientTlsPolicyIamUpdater = NewGenericResourceIamUpdater(networkSecurityClientTlsPolicyIamResource, IamNetworkSecurityClientTlsPolicySchema)
+	NetworkSecurityClientTlsPolicyIdParseFunc   = genericIamIdParseFunc(networkSecurityClientTlsPolicyIamResource)
+)
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamOracleDatabaseCloudExadataInfrastructureSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var oracleDatabaseCloudExadataInfrastructureIamResource = GenericIamResource{
+	Type:                "oracle_database_cloud_exadata_infrastructure",
+	Description:         "Oracle Database Exadata infrastructure",
+	Api:                 "oracle_database",
+	PathTemplate:        "projects/{project}/locations/{location}/cloudExadataInfrastructures/{name}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewOracleDatabaseCloudExadataInfrastructureIamUpdater = NewGenericResourceIamUpdater(oracleDatabaseCloudExadataInfrastructureIamResource, IamOracleDatabaseCloudExadataInfrastructureSchema)
+	OracleDatabaseCloudExadataInfrastructureIdParseFunc   = genericIamIdParseFunc(oracleDatabaseCloudExadataInfrastructureIamResource)
+)
@@ -2,8 +2,10 @@ package google
 
 import (
 	"fmt"
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"strings"
 )
 
 var IamOrganizationSchema = map[string]*schema.Schema{
@@ -29,22 +31,28 @@ func NewOrganizationIamUpdater(d *schema.ResourceData, config *Config) (Resource
 func (u *OrganizationIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
 	p, err := u.Config.clientResourceManager.Organizations.GetIamPolicy("organizations/"+u.resourceId, &cloudresourcemanager.GetIamPolicyRequest{}).Do()
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving IAM policy for %s: %s", u.DescribeResource(), err)
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
 	return p, nil
 }
 
-func (u *OrganizationIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	_, err := u.Config.clientResourceManager.Organizations.SetIamPolicy("organizations/"+u.resourceId, &cloudresourcemanager.SetIamPolicyRequest{
+func (u *OrganizationIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	p, err := u.Config.clientResourceManager.Organizations.SetIamPolicy("organizations/"+u.resourceId, &cloudresourcemanager.SetIamPolicyRequest{
 		Policy: policy,
 	}).Do()
 
 	if err != nil {
-		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	return nil
+	return p, nil
+}
+
+func (u *OrganizationIamUpdater) CheckParentExists() error {
+	_, err := u.Config.clientResourceManager.Organizations.Get("organizations/" + u.resourceId).Fields("name").Do()
+
+	return iamParentError(u, err)
 }
 
 func (u *OrganizationIamUpdater) GetResourceId() string {
@@ -58,3 +66,15 @@ func (u *OrganizationIamUpdater) GetMutexKey() string {
 func (u *OrganizationIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("organization %q", u.resourceId)
 }
+
+func (u *OrganizationIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return IamResourceDescriptor{
+		ResourceType: "organization",
+		ResourceId:   u.GetResourceId(),
+	}
+}
+
+func OrganizationIdParseFunc(d *schema.ResourceData, config *Config) error {
+	d.Set("org_id", strings.TrimPrefix(d.Id(), "organizations/"))
+	return nil
+}
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamParallelstoreInstanceSchema = map[string]*schema.Schema{
+	"instance": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var parallelstoreInstanceIamResource = GenericIamResource{
+	Type:                "parallelstore_instance",
+	Description:         "Parallelstore instance",
+	Api:                 "parallelstore",
+	PathTemplate:        "projects/{project}/locations/{location}/instances/{instance}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewParallelstoreInstanceIamUpdater = NewGenericResourceIamUpdater(parallelstoreInstanceIamResource, IamParallelstoreInstanceSchema)
+	ParallelstoreInstanceIdParseFunc   = genericIamIdParseFunc(parallelstoreInstanceIamResource)
+)
@@ -2,8 +2,10 @@ package google
 
 import (
 	"fmt"
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"strings"
 )
 
 var IamProjectSchema = map[string]*schema.Schema{
@@ -36,22 +38,28 @@ func (u *ProjectIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy
 		&cloudresourcemanager.GetIamPolicyRequest{}).Do()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving IAM policy for %s: %s", u.DescribeResource(), err)
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
 	return p, nil
 }
 
-func (u *ProjectIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	_, err := u.Config.clientResourceManager.Projects.SetIamPolicy(u.resourceId, &cloudresourcemanager.SetIamPolicyRequest{
+func (u *ProjectIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	p, err := u.Config.clientResourceManager.Projects.SetIamPolicy(u.resourceId, &cloudresourcemanager.SetIamPolicyRequest{
 		Policy: policy,
 	}).Do()
 
 	if err != nil {
-		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	return nil
+	return p, nil
+}
+
+func (u *ProjectIamUpdater) CheckParentExists() error {
+	_, err := u.Config.clientResourceManager.Projects.Get(u.resourceId).Fields("projectId").Do()
+
+	return iamParentError(u, err)
 }
 
 func (u *ProjectIamUpdater) GetResourceId() string {
@@ -59,9 +67,27 @@ func (u *ProjectIamUpdater) GetResourceId() string {
 }
 
 func (u *ProjectIamUpdater) GetMutexKey() string {
-	return fmt.Sprintf("iam-project-%s", u.resourceId)
+	return projectIamMutexKey(u.resourceId)
 }
 
 func (u *ProjectIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("project %q", u.resourceId)
 }
+
+// projectIamMutexKey returns the key guarding the IAM policy of a project. It is shared by
+// google_project_iam_policy and the resources built on ProjectIamUpdater.
+func projectIamMutexKey(pid string) string {
+	return fmt.Sprintf("iam-project-%s", pid)
+}
+
+func (u *ProjectIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return IamResourceDescriptor{
+		ResourceType: "project",
+		ResourceId:   u.GetResourceId(),
+	}
+}
+
+func ProjectIdParseFunc(d *schema.ResourceData, config *Config) error {
+	d.Set("project", strings.TrimPrefix(d.Id(), "projects/"))
+	return nil
+}
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamPubsubLiteReservationSchema = map[string]*schema.Schema{
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"reservation": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var pubsubLiteReservationIamResource = GenericIamResource{
+	Type:                "pubsub_lite_reservation",
+	Description:         "Pub/Sub Lite reservation",
+	Api:                 "pubsub_lite",
+	PathTemplate:        "projects/{project}/locations/{region}/reservations/{reservation}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewPubsubLiteReservationIamUpdater = NewGenericResourceIamUpdater(pubsubLiteReservationIamResource, IamPubsubLiteReservationSchema)
+	PubsubLiteReservationIdParseFunc   = genericIamIdParseFunc(pubsubLiteReservationIamResource)
+)
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamPubsubLiteTopicSchema = map[string]*schema.Schema{
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"topic": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var pubsubLiteTopicIamResource = GenericIamResource{
+	Type:                "pubsub_lite_topic",
+	Description:         "Pub/Sub Lite topic",
+	Api:                 "pubsub_lite",
+	PathTemplate:        "projects/{project}/locations/{region}/topics/{topic}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewPubsubLiteTopicIamUpdater = NewGenericResourceIamUpdater(pubsubLiteTopicIamResource, IamPubsubLiteTopicSchema)
+	PubsubLiteTopicIdParseFunc   = genericIamIdParseFunc(pubsubLiteTopicIamResource)
+)
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamRedisInstanceSchema = map[string]*schema.Schema{
+	"instance": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var redisInstanceIamResource = GenericIamResource{
+	Type:                "redis_instance",
+	Description:         "Redis instance",
+	Api:                 "redis",
+	PathTemplate:        "projects/{project}/locations/{region}/instances/{instance}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewRedisInstanceIamUpdater = NewGenericResourceIamUpdater(redisInstanceIamResource, IamRedisInstanceSchema)
+	RedisInstanceIdParseFunc   = genericIamIdParseFunc(redisInstanceIamResource)
+)
@@ -0,0 +1,35 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamSecurityCenterV2FindingSourceSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"organization": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"source": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var securityCenterV2FindingSourceIamResource = GenericIamResource{
+	Type:               "scc_v2_organization_source",
+	Description:        "Security Command Center source",
+	Api:                "security_center_v2",
+	PathTemplate:       "organizations/{organization}/locations/{location}/sources/{source}",
+	GetIamPolicyMethod: "POST",
+}
+
+var (
+	NewSecurityCenterV2FindingSourceIamUpdater = NewGenericResourceIamUpdater(securityCenterV2FindingSourceIamResource, IamSecurityCenterV2FindingSourceSchema)
+	SecurityCenterV2FindingSourceIdParseFunc   = genericIamIdParseFunc(securityCenterV2FindingSourceIamResource)
+)
@@ -0,0 +1,123 @@
+package google
+
+import (
+	"fmt"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/iam/v1"
+	"strings"
+)
+
+var IamServiceAccountSchema = map[string]*schema.Schema{
+	"service_account_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type ServiceAccountIamUpdater struct {
+	serviceAccountId string
+	Config           *Config
+}
+
+func NewServiceAccountIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &ServiceAccountIamUpdater{
+		serviceAccountId: serviceAccountName(d.Get("service_account_id").(string)),
+		Config:           config,
+	}, nil
+}
+
+func (u *ServiceAccountIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	p, err := u.Config.clientIAM.Projects.ServiceAccounts.GetIamPolicy(u.serviceAccountId).Do()
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	v1Policy, err := iamPolicyToResourceManager(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1Policy, nil
+}
+
+func (u *ServiceAccountIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	iamPolicy, err := resourceManagerPolicyToIam(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := u.Config.clientIAM.Projects.ServiceAccounts.SetIamPolicy(u.serviceAccountId, &iam.SetIamPolicyRequest{
+		Policy: iamPolicy,
+	}).Do()
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	v1Policy, err := iamPolicyToResourceManager(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1Policy, nil
+}
+
+func (u *ServiceAccountIamUpdater) CheckParentExists() error {
+	_, err := u.Config.clientIAM.Projects.ServiceAccounts.Get(u.serviceAccountId).Fields("name").Do()
+
+	return iamParentError(u, err)
+}
+
+func (u *ServiceAccountIamUpdater) GetResourceId() string {
+	return u.serviceAccountId
+}
+
+func (u *ServiceAccountIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-service-account-%s", u.serviceAccountId)
+}
+
+func (u *ServiceAccountIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("service account %q", u.serviceAccountId)
+}
+
+func (u *ServiceAccountIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return newRestIamResourceDescriptor("service_account", u.serviceAccountId)
+}
+
+func ServiceAccountIdParseFunc(d *schema.ResourceData, config *Config) error {
+	d.Set("service_account_id", d.Id())
+	return nil
+}
+
+// serviceAccountName returns the name of a service account given either by its name, i.e.
+// `projects/{project}/serviceAccounts/{email}`, or by its email. The project of a service account given by
+// its email is inferred by the API.
+func serviceAccountName(id string) string {
+	if strings.Contains(id, "/") {
+		return id
+	}
+	return "projects/-/serviceAccounts/" + id
+}
+
+// The iam and cloudresourcemanager v1 policies are identical.
+func resourceManagerPolicyToIam(in *cloudresourcemanager.Policy) (*iam.Policy, error) {
+	out := &iam.Policy{}
+	err := Convert(in, out)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot convert a v1 policy to an iam policy: %s", err)
+	}
+	return out, nil
+}
+
+func iamPolicyToResourceManager(in *iam.Policy) (*cloudresourcemanager.Policy, error) {
+	out := &cloudresourcemanager.Policy{}
+	err := Convert(in, out)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot convert an iam policy to a v1 policy: %s", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamServiceDirectoryNamespaceSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"namespace": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var serviceDirectoryNamespaceIamResource = GenericIamResource{
+	Type:               "service_directory_namespace",
+	Description:        "Service Directory namespace",
+	Api:                "service_directory",
+	PathTemplate:       "projects/{project}/locations/{location}/namespaces/{namespace}",
+	GetIamPolicyMethod: "POST",
+}
+
+var (
+	NewServiceDirectoryNamespaceIamUpdater = NewGenericResourceIamUpdater(serviceDirectoryNamespaceIamResource, IamServiceDirectoryNamespaceSchema)
+	ServiceDirectoryNamespaceIdParseFunc   = genericIamIdParseFunc(serviceDirectoryNamespaceIamResource)
+)
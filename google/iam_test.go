@@ -0,0 +1,1604 @@
+package google
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// testIamUpdater is an in-memory ResourceIamUpdater used to unit test the generic IAM resources.
+type testIamUpdater struct {
+	policy    *cloudresourcemanager.Policy
+	getErr    error
+	parentErr error
+
+	// When checkEtag is set, SetResourceIamPolicy fails with a conflict if the policy wasn't read
+	// from the latest version, as the API does. readDelay widens the read-modify-write window.
+	// When conflictOnFirstWrite is set, the first write fails with a conflict, as if the policy had
+	// been changed concurrently.
+	checkEtag            bool
+	readDelay            time.Duration
+	conflictOnFirstWrite bool
+
+	mu        sync.Mutex
+	getCalls  int
+	setCalls  int
+	conflicts int
+}
+
+func newTestIamUpdater(bindings ...*cloudresourcemanager.Binding) *testIamUpdater {
+	return &testIamUpdater{
+		policy: &cloudresourcemanager.Policy{
+			Bindings: bindings,
+			Etag:     "BwVZ1Q==",
+		},
+	}
+}
+
+func (u *testIamUpdater) newUpdaterFunc() newResourceIamUpdaterFunc {
+	return func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		return u, nil
+	}
+}
+
+func (u *testIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	u.mu.Lock()
+	u.getCalls++
+	if u.getErr != nil {
+		u.mu.Unlock()
+		return nil, u.getErr
+	}
+	p := &cloudresourcemanager.Policy{}
+	err := Convert(u.policy, p)
+	u.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	time.Sleep(u.readDelay)
+	return p, nil
+}
+
+func (u *testIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.setCalls++
+	if u.checkEtag && policy.Etag != u.policy.Etag || u.conflictOnFirstWrite && u.setCalls == 1 {
+		u.conflicts++
+		return nil, &googleapi.Error{Code: 409, Message: "There were concurrent policy changes."}
+	}
+
+	p := &cloudresourcemanager.Policy{}
+	if err := Convert(policy, p); err != nil {
+		return nil, err
+	}
+	p.Etag = fmt.Sprintf("etag-%d", u.setCalls)
+	u.policy = p
+
+	applied := &cloudresourcemanager.Policy{}
+	if err := Convert(p, applied); err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+// testContendedIamUpdater is a testIamUpdater whose policy is always changed concurrently, so that setting it
+// always conflicts.
+type testContendedIamUpdater struct {
+	*testIamUpdater
+}
+
+func (u *testContendedIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.setCalls++
+	u.conflicts++
+	return nil, &googleapi.Error{Code: 409, Message: "There were concurrent policy changes."}
+}
+
+func (u *testIamUpdater) CheckParentExists() error {
+	return iamParentError(u, u.parentErr)
+}
+
+func (u *testIamUpdater) GetResourceId() string {
+	return "test-resource"
+}
+
+func (u *testIamUpdater) GetMutexKey() string {
+	return "iam-test-test-resource"
+}
+
+func (u *testIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("test resource %q", u.GetResourceId())
+}
+
+func (u *testIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return IamResourceDescriptor{
+		ResourceType: "test",
+		ResourceId:   u.GetResourceId(),
+	}
+}
+
+func TestIamParentError(t *testing.T) {
+	u := newTestIamUpdater()
+	cases := map[string]struct {
+		err    error
+		expect string
+	}{
+		"no error": {
+			err: nil,
+		},
+		"not found": {
+			err:    &googleapi.Error{Code: 404},
+			expect: `test resource "test-resource" not found`,
+		},
+		"forbidden": {
+			err:    &googleapi.Error{Code: 403},
+			expect: `Error checking that test resource "test-resource" exists`,
+		},
+		"other error": {
+			err:    &googleapi.Error{Code: 500},
+			expect: `Error checking that test resource "test-resource" exists`,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := iamParentError(u, tc.err)
+		if tc.expect == "" {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %s", tn, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasPrefix(err.Error(), tc.expect) {
+			t.Errorf("%s: expected error starting with %q, got %v", tn, tc.expect, err)
+		}
+	}
+}
+
+func TestIamBindingCreate_missingParent(t *testing.T) {
+	u := newTestIamUpdater()
+	u.getErr = &googleapi.Error{Code: 404, Message: "Not found"}
+	u.parentErr = &googleapi.Error{Code: 404, Message: "Not found"}
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+
+	err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{})
+	if err == nil || !strings.Contains(err.Error(), `test resource "test-resource" not found`) {
+		t.Fatalf("Expected a missing parent error, got %v", err)
+	}
+	if u.setCalls != 0 {
+		t.Fatalf("Expected the policy to be left untouched, got %d set calls", u.setCalls)
+	}
+
+	// The check is skipped with skip_iam_parent_check, leaving the error of the policy read.
+	err = resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{SkipIamParentCheck: true})
+	if err == nil || strings.Contains(err.Error(), `test resource "test-resource" not found`) {
+		t.Fatalf("Expected the error of the policy read, got %v", err)
+	}
+}
+
+func TestIamBindingCreate_parentNotGettable(t *testing.T) {
+	// The caller may manage the policy without being allowed to get the resource holding it.
+	u := newTestIamUpdater()
+	u.parentErr = &googleapi.Error{Code: 403, Message: "Permission denied"}
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+
+	if err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The error of the policy read is kept when the check is denied too.
+	u.getErr = &googleapi.Error{Code: 403, Message: "Policy read denied"}
+	err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{})
+	if err == nil || !strings.Contains(err.Error(), "Policy read denied") {
+		t.Fatalf("Expected the error of the policy read, got %v", err)
+	}
+}
+
+func TestIamBindingCreate_accessibleParent(t *testing.T) {
+	u := newTestIamUpdater()
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+
+	if err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if d.Id() != "test-resource/roles/viewer" {
+		t.Fatalf("Expected id %q, got %q", "test-resource/roles/viewer", d.Id())
+	}
+	if u.setCalls != 1 {
+		t.Fatalf("Expected 1 set call, got %d", u.setCalls)
+	}
+}
+
+func TestIamBindingCreate_strictCreate(t *testing.T) {
+	for _, strict := range []bool{true, false} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com", "user:foreign@example.com"},
+		})
+
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":          "roles/viewer",
+			"members":       []interface{}{"user:admin@example.com"},
+			"strict_create": strict,
+		})
+
+		err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{})
+		if strict {
+			if err == nil || !strings.Contains(err.Error(), "user:foreign@example.com") {
+				t.Fatalf("Expected an error listing the foreign member, got %v", err)
+			}
+			if u.setCalls != 0 || d.Id() != "" {
+				t.Fatalf("Expected the binding not to be created, got %d set calls and id %q", u.setCalls, d.Id())
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		members := convertStringSet(d.Get("members").(*schema.Set))
+		sort.Strings(members)
+		if expected := []string{"user:admin@example.com", "user:foreign@example.com"}; !reflect.DeepEqual(members, expected) {
+			t.Fatalf("Expected the foreign member to be kept and read, got %v", members)
+		}
+	}
+}
+
+func TestIamBindingCreate_authoritativeOnCreate(t *testing.T) {
+	cases := []struct {
+		providerDefault bool
+		resource        interface{}
+		authoritative   bool
+	}{
+		{false, nil, false},
+		{true, nil, true},
+		{false, true, true},
+		{true, false, false},
+	}
+
+	for _, c := range cases {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com", "user:foreign@example.com"},
+		})
+
+		raw := map[string]interface{}{
+			"role":    "roles/viewer",
+			"members": []interface{}{"user:admin@example.com"},
+		}
+		if c.resource != nil {
+			raw["authoritative_on_create"] = c.resource
+		}
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, raw)
+
+		if err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{IamBindingAuthoritativeOnCreate: c.providerDefault}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := map[string]map[string]bool{
+			"roles/viewer": {"user:admin@example.com": true, "user:foreign@example.com": true},
+		}
+		if c.authoritative {
+			expected["roles/viewer"] = map[string]bool{"user:admin@example.com": true}
+		}
+		if bm := rolesToMembersMap(u.policy.Bindings); !reflect.DeepEqual(bm, expected) {
+			t.Errorf("provider default %t, resource %v: expected bindings %v, got %v", c.providerDefault, c.resource, expected, bm)
+		}
+	}
+}
+
+func TestIamBindingDelete_checkEtag(t *testing.T) {
+	for _, stateEtag := range []string{"BwVZ1Q==", "BwVZ0A=="} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com"},
+		})
+
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":                 "roles/viewer",
+			"members":              []interface{}{"user:admin@example.com"},
+			"check_etag_on_delete": true,
+		})
+		d.SetId("test-resource/roles/viewer")
+		d.Set("etag", stateEtag)
+
+		err := resourceIamBindingDelete(u.newUpdaterFunc())(d, &Config{})
+		if stateEtag == "BwVZ1Q==" {
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(u.policy.Bindings) != 0 {
+				t.Errorf("Expected the binding to be removed, got %v", u.policy.Bindings)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), "changed since it was last read") {
+			t.Fatalf("Expected an error for the stale etag, got %v", err)
+		}
+		if u.setCalls != 0 {
+			t.Errorf("Expected the policy not to be set, got %d calls", u.setCalls)
+		}
+	}
+}
+
+// testNotFoundIamUpdater is a testIamUpdater whose parent doesn't exist, or isn't accessible.
+type testNotFoundIamUpdater struct {
+	*testIamUpdater
+	code int
+}
+
+func (u *testNotFoundIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	return nil, errwrap.Wrapf("Error retrieving IAM policy for test resource: {{err}}", &googleapi.Error{Code: u.code, Message: "Requested entity was not found."})
+}
+
+func TestIamDelete_parentNotFound(t *testing.T) {
+	deletes := map[string]struct {
+		schema map[string]*schema.Schema
+		raw    map[string]interface{}
+		delete func(newResourceIamUpdaterFunc) schema.DeleteFunc
+	}{
+		"binding": {
+			schema: iamBindingSchema,
+			raw:    map[string]interface{}{"role": "roles/viewer", "members": []interface{}{"user:admin@example.com"}},
+			delete: resourceIamBindingDelete,
+		},
+		"member": {
+			schema: IamMemberBaseSchema,
+			raw:    map[string]interface{}{"role": "roles/viewer", "member": "user:admin@example.com"},
+			delete: resourceIamMemberDelete,
+		},
+		"policy": {
+			schema: IamPolicyBaseSchema,
+			raw:    map[string]interface{}{"policy_data": `{"bindings":[{"role":"roles/viewer","members":["user:admin@example.com"]}]}`},
+			delete: ResourceIamPolicyDelete,
+		},
+	}
+
+	for tn, tc := range deletes {
+		for _, code := range []int{404, 403} {
+			u := &testNotFoundIamUpdater{testIamUpdater: newTestIamUpdater(), code: code}
+			newUpdaterFunc := func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+				return u, nil
+			}
+
+			d := schema.TestResourceDataRaw(t, tc.schema, tc.raw)
+			d.SetId("test-resource")
+			err := tc.delete(newUpdaterFunc)(d, &Config{})
+
+			// The IAM resource can't exist if its parent doesn't, so there's nothing to delete.
+			if code == 404 {
+				if err != nil || d.Id() != "" {
+					t.Errorf("%s: Expected the resource to be removed from state, got error %v and id %q", tn, err, d.Id())
+				}
+				continue
+			}
+			if err == nil || d.Id() == "" {
+				t.Errorf("%s: Expected the delete to fail for a %d, got error %v and id %q", tn, code, err, d.Id())
+			}
+		}
+	}
+}
+
+func TestIamBindingCreate_emptyMembers(t *testing.T) {
+	for _, allowEmpty := range []bool{false, true} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com"},
+		})
+
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":                    "roles/viewer",
+			"members":                 []interface{}{},
+			"authoritative_on_create": true,
+			"allow_empty":             allowEmpty,
+		})
+
+		err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{})
+		if !allowEmpty {
+			if err == nil || !strings.Contains(err.Error(), "has no members") {
+				t.Fatalf("Expected an error for the empty binding, got %v", err)
+			}
+			if u.setCalls != 0 {
+				t.Errorf("Expected the policy not to be set, got %d calls", u.setCalls)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if bm := rolesToMembersMap(u.policy.Bindings); len(bm["roles/viewer"]) != 0 {
+			t.Errorf("Expected the binding to be emptied, got %v", bm)
+		}
+	}
+}
+
+func TestReadIamMembersFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "iam-members")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "members.txt")
+	contents := "# Generated by the directory sync.\nuser:admin@example.com\n\n  group:admins@example.com  \n\t\n# serviceAccount:disabled@example.com\n"
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	members, err := readIamMembersFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if expected := []string{"user:admin@example.com", "group:admins@example.com"}; !reflect.DeepEqual(members, expected) {
+		t.Errorf("Expected members %v, got %v", expected, members)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("user:admin@example.com\nadmin@example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readIamMembersFile(path); err == nil || !strings.Contains(err.Error(), path+":2") {
+		t.Errorf("Expected an error for the invalid member on line 2, got %v", err)
+	}
+
+	if _, err := readIamMembersFile(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Errorf("Expected an error for the missing file")
+	}
+}
+
+func TestIamBindingCreate_membersFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "iam-members")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "members.txt")
+	if err := ioutil.WriteFile(path, []byte("user:admin@example.com\ngroup:admins@example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	u := newTestIamUpdater()
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":         "roles/viewer",
+		"members":      []interface{}{"user:admin@example.com", "serviceAccount:app@example.com"},
+		"members_file": path,
+	})
+
+	if err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The members of the file are merged with `members`.
+	expected := map[string]map[string]bool{
+		"roles/viewer": {"user:admin@example.com": true, "group:admins@example.com": true, "serviceAccount:app@example.com": true},
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); !reflect.DeepEqual(bm, expected) {
+		t.Fatalf("Expected bindings %v, got %v", expected, bm)
+	}
+	// Only the members set inline are stored in `members`, so that the others don't show as a diff.
+	if members := convertStringSet(d.Get("members").(*schema.Set)); !reflect.DeepEqual(members, []string{"serviceAccount:app@example.com", "user:admin@example.com"}) {
+		t.Errorf("Expected the inline members in state, got %v", members)
+	}
+	if v := d.Get("members_file").(string); v != path {
+		t.Errorf("Expected members_file to be %q, got %q", path, v)
+	}
+
+	// A member added to the file isn't granted the role yet, so `members_file` is cleared to show a diff.
+	if err := ioutil.WriteFile(path, []byte("user:admin@example.com\ngroup:admins@example.com\nuser:new@example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := resourceIamBindingRead(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if v := d.Get("members_file").(string); v != "" {
+		t.Errorf("Expected members_file to be cleared, got %q", v)
+	}
+}
+
+// testLaggingIamUpdater is a testIamUpdater whose policy is returned without its bindings by the get calls in
+// hiddenOnCalls, as if the bindings weren't propagated yet.
+type testLaggingIamUpdater struct {
+	*testIamUpdater
+	hiddenOnCalls map[int]bool
+}
+
+func (u *testLaggingIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	p, err := u.testIamUpdater.GetResourceIamPolicy()
+	if err != nil || !u.hiddenOnCalls[u.getCalls] {
+		return p, err
+	}
+	p.Bindings = nil
+	return p, nil
+}
+
+func TestIamBindingCreate_readAfterCreate(t *testing.T) {
+	for _, wait := range []time.Duration{0, 5 * time.Second} {
+		// The binding already exists, so the creation doesn't write the policy but reads it back, which doesn't
+		// show the binding the first time.
+		u := &testLaggingIamUpdater{
+			testIamUpdater: newTestIamUpdater(&cloudresourcemanager.Binding{
+				Role:    "roles/viewer",
+				Members: []string{"user:admin@example.com"},
+			}),
+			hiddenOnCalls: map[int]bool{2: true},
+		}
+		newUpdaterFunc := func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+			return u, nil
+		}
+
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":    "roles/viewer",
+			"members": []interface{}{"user:admin@example.com"},
+		})
+
+		if err := resourceIamBindingCreate(newUpdaterFunc)(d, &Config{IamReadAfterCreateWait: wait}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if wait == 0 {
+			if d.Id() != "" || u.getCalls != 2 {
+				t.Errorf("Expected the binding to be removed from state after a single read, got ID %q after %d reads", d.Id(), u.getCalls)
+			}
+			continue
+		}
+		if d.Id() != "test-resource/roles/viewer" || u.getCalls != 3 {
+			t.Errorf("Expected the binding to be kept in state after a second read, got ID %q after %d reads", d.Id(), u.getCalls)
+		}
+	}
+}
+
+func TestIamBindingCreate_strictCreateWithoutForeignMembers(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:admin@example.com"},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:foreign@example.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":          "roles/viewer",
+		"members":       []interface{}{"user:admin@example.com", "group:admins@example.com"},
+		"strict_create": true,
+	})
+
+	if err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if d.Id() != "test-resource/roles/viewer" {
+		t.Fatalf("Expected id %q, got %q", "test-resource/roles/viewer", d.Id())
+	}
+}
+
+func TestListResourceIamBindings(t *testing.T) {
+	u := newTestIamUpdater(
+		&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:b@example.com"},
+		},
+		&cloudresourcemanager.Binding{
+			Role:    "roles/editor",
+			Members: []string{"user:admin@example.com"},
+		},
+		&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:a@example.com"},
+		},
+	)
+
+	bindings, err := listResourceIamBindings(u)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []*cloudresourcemanager.Binding{
+		{Role: "roles/editor", Members: []string{"user:admin@example.com"}},
+		{Role: "roles/viewer", Members: []string{"user:a@example.com", "user:b@example.com"}},
+	}
+	if !reflect.DeepEqual(bindings, expected) {
+		t.Fatalf("Expected bindings %v, got %v", expected, bindings)
+	}
+}
+
+func TestIamBindingImport(t *testing.T) {
+	u := newTestIamUpdater(
+		&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com"},
+		},
+		&cloudresourcemanager.Binding{
+			Role:    "roles/editor",
+			Members: []string{"user:admin@example.com"},
+		},
+	)
+	var parsed []string
+	r := ResourceIamBindingWithImport(map[string]*schema.Schema{}, u.newUpdaterFunc(), func(d *schema.ResourceData, config *Config) error {
+		parsed = append(parsed, d.Id())
+		return nil
+	})
+
+	d := r.Data(nil)
+	d.SetId("test-resource roles/viewer")
+	imported, err := r.Importer.State(d, &Config{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("Expected a single imported resource, got %d", len(imported))
+	}
+	d = imported[0]
+	if d.Id() != "test-resource/roles/viewer" || d.Get("role").(string) != "roles/viewer" {
+		t.Fatalf("Expected the binding for roles/viewer to be imported, got id %q and role %q", d.Id(), d.Get("role").(string))
+	}
+	if len(parsed) != 1 || parsed[0] != "test-resource" {
+		t.Fatalf("Expected the parent ID to be parsed, got %v", parsed)
+	}
+
+	// The ID of the parent alone lists the roles to pick from.
+	d = r.Data(nil)
+	d.SetId("test-resource")
+	_, err = r.Importer.State(d, &Config{})
+	if err == nil || !strings.Contains(err.Error(), "roles/editor, roles/viewer") {
+		t.Fatalf("Expected an error listing the roles, got %v", err)
+	}
+}
+
+func TestProjectIamBindingImport_roles(t *testing.T) {
+	var requests []string
+	client := &http.Client{Transport: testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		return testResponse(200, `{"etag":"BwV=","bindings":[{"role":"roles/viewer","members":["user:admin@example.com"]},{"role":"roles/owner","members":["user:admin@example.com"]}]}`), nil
+	})}
+	crm, err := cloudresourcemanager.New(client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	config := &Config{clientResourceManager: crm}
+
+	// The ID of the project alone lists the roles to pick from.
+	r := Provider().(*schema.Provider).ResourcesMap["google_project_iam_binding"]
+	d := r.Data(nil)
+	d.SetId("my-project")
+	_, err = r.Importer.State(d, config)
+	if err == nil || !strings.Contains(err.Error(), "roles/owner, roles/viewer") {
+		t.Fatalf("Expected an error listing the roles, got %v", err)
+	}
+	if len(requests) != 1 || requests[0] != "POST /v1/projects/my-project:getIamPolicy" {
+		t.Fatalf("Expected the policy of the project to be read, got %v", requests)
+	}
+
+	d = r.Data(nil)
+	d.SetId("projects/my-project roles/viewer")
+	imported, err := r.Importer.State(d, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(imported) != 1 || imported[0].Id() != "my-project/roles/viewer" || imported[0].Get("project").(string) != "my-project" {
+		t.Fatalf("Expected the binding of roles/viewer of my-project to be imported, got %v", imported)
+	}
+}
+
+func TestIamBindingLastApplied(t *testing.T) {
+	u := newTestIamUpdater()
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+
+	if err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	etag := d.Get("last_applied_etag").(string)
+	appliedAt := d.Get("last_applied_time").(string)
+	if etag != u.policy.Etag {
+		t.Fatalf("Expected last_applied_etag to be %q, got %q", u.policy.Etag, etag)
+	}
+	if _, err := time.Parse(time.RFC3339, appliedAt); err != nil {
+		t.Fatalf("Expected last_applied_time to be a RFC3339 timestamp, got %q", appliedAt)
+	}
+
+	// The binding already grants the role to its members, so the update doesn't write anything.
+	if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if u.setCalls != 1 {
+		t.Fatalf("Expected no-op update not to set the policy, got %d set calls", u.setCalls)
+	}
+	if d.Get("last_applied_etag").(string) != etag || d.Get("last_applied_time").(string) != appliedAt {
+		t.Fatalf("Expected no-op update to leave the last applied attributes unchanged")
+	}
+
+	d.Set("members", []interface{}{"user:admin@example.com", "group:admins@example.com"})
+	if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if u.setCalls != 2 {
+		t.Fatalf("Expected the update to set the policy, got %d set calls", u.setCalls)
+	}
+	if v := d.Get("last_applied_etag").(string); v == etag || v != u.policy.Etag {
+		t.Fatalf("Expected last_applied_etag to be updated to %q, got %q", u.policy.Etag, v)
+	}
+}
+
+func TestIamBindingCreate_exportPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "iam-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:foreign@example.com"},
+	})
+	path := filepath.Join(dir, "policy.json")
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":             "roles/viewer",
+		"members":          []interface{}{"user:admin@example.com"},
+		"export_policy_to": path,
+	})
+
+	if err := resourceIamBindingCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected the policy to be exported: %s", err)
+	}
+	exported := &cloudresourcemanager.Policy{}
+	if err := json.Unmarshal(b, exported); err != nil {
+		t.Fatalf("Invalid exported policy %s: %s", b, err)
+	}
+	if exported.Etag != u.policy.Etag {
+		t.Errorf("Expected the exported policy to have etag %q, got %q", u.policy.Etag, exported.Etag)
+	}
+	expected := map[string]map[string]bool{
+		"roles/editor": {"user:foreign@example.com": true},
+		"roles/viewer": {"user:admin@example.com": true},
+	}
+	if bm := rolesToMembersMap(exported.Bindings); !reflect.DeepEqual(bm, expected) {
+		t.Errorf("Expected the exported bindings to be %v, got %v", expected, bm)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("Expected only the exported policy in %s, got %d files", dir, len(files))
+	}
+
+	// Failing to export the policy doesn't fail the apply.
+	d.Set("export_policy_to", filepath.Join(dir, "missing", "policy.json"))
+	d.Set("members", []interface{}{"user:admin@example.com", "group:admins@example.com"})
+	if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestIamBindingUpdate_stateFromAppliedPolicy(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:admin@example.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com", "group:admins@example.com"},
+	})
+	d.SetId("test-resource/roles/viewer")
+
+	if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	// The state is set from the policy returned by the write, without reading it back.
+	if u.getCalls != 1 || u.setCalls != 1 {
+		t.Fatalf("Expected 1 get and 1 set call, got %d get and %d set calls", u.getCalls, u.setCalls)
+	}
+	if d.Get("etag").(string) != u.policy.Etag {
+		t.Fatalf("Expected etag %q, got %q", u.policy.Etag, d.Get("etag"))
+	}
+	members := convertStringSet(d.Get("members").(*schema.Set))
+	sort.Strings(members)
+	if !reflect.DeepEqual(members, []string{"group:admins@example.com", "user:admin@example.com"}) {
+		t.Fatalf("Unexpected members %v", members)
+	}
+
+	// Nothing is written when the policy is up to date, so the state is read.
+	if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if u.getCalls != 3 || u.setCalls != 1 {
+		t.Fatalf("Expected 3 get and 1 set calls, got %d get and %d set calls", u.getCalls, u.setCalls)
+	}
+}
+
+func TestIamBindingUpdate_systemManagedMembers(t *testing.T) {
+	serviceAgent := "serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"
+	for _, patterns := range [][]interface{}{nil, {"serviceAccount:service-*@*.gserviceaccount.com"}} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com", serviceAgent},
+		})
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":                   "roles/viewer",
+			"members":                []interface{}{"user:admin@example.com"},
+			"system_managed_members": patterns,
+		})
+
+		if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		bm := rolesToMembersMap(u.policy.Bindings)
+		if patterns == nil {
+			// Without the patterns, the service agent is removed, and would be added back by Google.
+			if bm["roles/viewer"][serviceAgent] || u.setCalls != 1 {
+				t.Fatalf("Expected the service agent to be removed, got %v after %d set calls", bm, u.setCalls)
+			}
+			continue
+		}
+
+		if !bm["roles/viewer"][serviceAgent] || u.setCalls != 0 {
+			t.Fatalf("Expected the service agent to be kept without writing the policy, got %v after %d set calls", bm, u.setCalls)
+		}
+		if members := convertStringSet(d.Get("members").(*schema.Set)); !reflect.DeepEqual(members, []string{"user:admin@example.com"}) {
+			t.Errorf("Expected the service agent to be absent from state, got %v", members)
+		}
+
+		if err := resourceIamBindingDelete(u.newUpdaterFunc())(d, &Config{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if bm := rolesToMembersMap(u.policy.Bindings); len(bm["roles/viewer"]) != 1 || !bm["roles/viewer"][serviceAgent] {
+			t.Errorf("Expected only the service agent to be left after delete, got %v", bm)
+		}
+	}
+}
+
+func TestIamBindingUpdate_additive(t *testing.T) {
+	for _, additive := range []bool{false, true} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com", "user:foreign@example.com"},
+		})
+
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":            "roles/viewer",
+			"members":         []interface{}{"user:admin@example.com", "group:admins@example.com"},
+			"additive_update": additive,
+		})
+		d.SetId("test-resource/roles/viewer")
+
+		if err := resourceIamBindingUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := map[string]map[string]bool{
+			"roles/viewer": {"user:admin@example.com": true, "group:admins@example.com": true},
+		}
+		if additive {
+			expected["roles/viewer"]["user:foreign@example.com"] = true
+		}
+		if bm := rolesToMembersMap(u.policy.Bindings); !reflect.DeepEqual(bm, expected) {
+			t.Errorf("additive=%t: expected bindings %v, got %v", additive, expected, bm)
+		}
+
+		// The foreign member isn't in state either way, so there is no diff.
+		members := convertStringSet(d.Get("members").(*schema.Set))
+		sort.Strings(members)
+		if !reflect.DeepEqual(members, []string{"group:admins@example.com", "user:admin@example.com"}) {
+			t.Errorf("additive=%t: unexpected members %v", additive, members)
+		}
+	}
+}
+
+// testAccCheckIamBindingMembers checks that the IAM policy returned by the updater
+// grants role to exactly the given members.
+func testAccCheckIamBindingMembers(newUpdater func(config *Config) ResourceIamUpdater, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		updater := newUpdater(config)
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+
+		for _, binding := range p.Bindings {
+			if binding.Role == role {
+				sort.Strings(members)
+				sort.Strings(binding.Members)
+
+				if reflect.DeepEqual(members, binding.Members) {
+					return nil
+				}
+
+				return fmt.Errorf("Binding found but expected members is %v, got %v", members, binding.Members)
+			}
+		}
+
+		return fmt.Errorf("No binding for role %q in policy of %s", role, updater.DescribeResource())
+	}
+}
+
+// testAccSkipIfIamUnsupported skips the test if the API doesn't implement the IAM methods
+// for the resource of the updater, which is the case for some resources in some locations.
+func testAccSkipIfIamUnsupported(t *testing.T, newUpdater func(config *Config) ResourceIamUpdater) {
+	config := &Config{
+		Credentials: getTestCredsFromEnv(),
+		Project:     getTestProjectFromEnv(),
+		Region:      getTestRegionFromEnv(),
+	}
+	if err := config.loadAndValidate(); err != nil {
+		t.Fatalf("Error configuring the provider: %s", err)
+	}
+
+	updater := newUpdater(config)
+	if _, err := updater.GetResourceIamPolicy(); isIamUnsupportedError(err) {
+		t.Skipf("IAM policies are not supported for %s: %s", updater.DescribeResource(), err)
+	}
+}
+
+func TestParseIamImportId(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"project":  {Type: schema.TypeString, Optional: true},
+		"location": {Type: schema.TypeString, Optional: true},
+		"cluster":  {Type: schema.TypeString, Optional: true},
+	}
+	format := "projects/{project}/locations/{location}/clusters/{cluster}"
+
+	cases := map[string]struct {
+		id     string
+		err    bool
+		fields map[string]string
+	}{
+		"valid": {
+			id: "projects/my-project/locations/us-central1/clusters/my-cluster",
+			fields: map[string]string{
+				"project":  "my-project",
+				"location": "us-central1",
+				"cluster":  "my-cluster",
+			},
+		},
+		"missing segment": {
+			id:  "projects/my-project/locations/us-central1/clusters",
+			err: true,
+		},
+		"wrong collection": {
+			id:  "projects/my-project/locations/us-central1/instances/my-cluster",
+			err: true,
+		},
+		"empty segment": {
+			id:  "projects//locations/us-central1/clusters/my-cluster",
+			err: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+		d.SetId(tc.id)
+		err := parseIamImportId(d, format)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		for field, expected := range tc.fields {
+			if v := d.Get(field).(string); v != expected {
+				t.Errorf("%s: expected %s to be %q, got %q", tn, field, expected, v)
+			}
+		}
+	}
+}
+
+func TestIamResourceDescriptor(t *testing.T) {
+	cases := map[string]struct {
+		updater  ResourceIamUpdater
+		expected IamResourceDescriptor
+		json     string
+	}{
+		"project": {
+			updater: &ProjectIamUpdater{resourceId: "my-project"},
+			expected: IamResourceDescriptor{
+				ResourceType: "project",
+				ResourceId:   "my-project",
+			},
+			json: `{"resource_type":"project","resource_id":"my-project"}`,
+		},
+		"container cluster": {
+			updater: &GenericResourceIamUpdater{resource: containerClusterIamResource, resourceId: "projects/my-project/locations/global/memberships/my-cluster"},
+			expected: IamResourceDescriptor{
+				ResourceType: "container_cluster",
+				ResourceId:   "projects/my-project/locations/global/memberships/my-cluster",
+				Parent:       "projects/my-project/locations/global",
+			},
+			json: `{"resource_type":"container_cluster","resource_id":"projects/my-project/locations/global/memberships/my-cluster","parent":"projects/my-project/locations/global"}`,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := tc.updater.GetResourceDescriptor()
+		if d != tc.expected {
+			t.Errorf("%s: expected descriptor %+v, got %+v", tn, tc.expected, d)
+		}
+
+		b, err := json.Marshal(d)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if string(b) != tc.json {
+			t.Errorf("%s: expected JSON %s, got %s", tn, tc.json, string(b))
+		}
+	}
+}
+
+func TestIamWritesToOneParentSerialize(t *testing.T) {
+	u := newTestIamUpdater()
+	u.checkEtag = true
+	u.readDelay = 50 * time.Millisecond
+
+	binding := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+	member := schema.TestResourceDataRaw(t, IamMemberBaseSchema, map[string]interface{}{
+		"role":   "roles/editor",
+		"member": "user:admin@example.com",
+	})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		errs[0] = resourceIamBindingCreate(u.newUpdaterFunc())(binding, &Config{})
+	}()
+	go func() {
+		defer wg.Done()
+		errs[1] = resourceIamMemberCreate(u.newUpdaterFunc())(member, &Config{})
+	}()
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	if u.conflicts != 0 {
+		t.Fatalf("Expected the writes to be serialized, got %d conflicts", u.conflicts)
+	}
+
+	bm := rolesToMembersMap(u.policy.Bindings)
+	if !bm["roles/viewer"]["user:admin@example.com"] || !bm["roles/editor"]["user:admin@example.com"] {
+		t.Fatalf("Expected the policy to hold both bindings, got %v", bm)
+	}
+}
+
+func TestIamPolicyReadModifyWrite_maxTotalRetryDuration(t *testing.T) {
+	u := &testContendedIamUpdater{testIamUpdater: newTestIamUpdater()}
+
+	start := time.Now()
+	_, err := iamPolicyReadModifyWrite(&Config{IamMaxTotalRetryDuration: 1500 * time.Millisecond}, u, func(p *cloudresourcemanager.Policy) error {
+		p.Bindings = append(p.Bindings, &cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com"},
+		})
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "IAM policy contention exceeded max retry duration") {
+		t.Fatalf("Expected the contention to exceed the max retry duration, got %v", err)
+	}
+	// The write is retried once after 1s, as retrying again after a 2s backoff would exceed the cap.
+	if u.setCalls != 2 {
+		t.Errorf("Expected 2 set calls, got %d", u.setCalls)
+	}
+	if elapsed := time.Since(start); elapsed > 1500*time.Millisecond {
+		t.Errorf("Expected the retries to stop within the cap, took %s", elapsed)
+	}
+}
+
+func TestValidateIamMember(t *testing.T) {
+	cases := map[string]bool{
+		"allUsers":                       true,
+		"allAuthenticatedUsers":          true,
+		"user:admin@example.com":         true,
+		"serviceAccount:foo@example.com": true,
+		"group:admins@example.com":       true,
+		"domain:example.com":             true,
+		"projectOwner:my-project":        true,
+		"principalSet://iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/my-pool/attribute.repository/my-org/my-repo": true,
+		"principal://iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/my-pool/subject/my-subject":                     true,
+		"principalSet://cloudresourcemanager.googleapis.com/projects/123/type/ServiceAccount":                                               true,
+		"principalSet://cloudresourcemanager.googleapis.com/folders/456/type/ServiceAccount":                                                true,
+		"principalSet://cloudresourcemanager.googleapis.com/organizations/789/type/ServiceAccount":                                          true,
+		"principalSet://cloudresourcemanager.googleapis.com/billingAccounts/012/type/ServiceAccount":                                        false,
+		"principalSet://cloudresourcemanager.googleapis.com/projects/123":                                                                   false,
+		"principalSet:my-pool":                           false,
+		"principal:iam.googleapis.com/projects/123":      false,
+		"deleted:serviceAccount:foo@example.com?uid=123": true,
+		"deleted:user:admin@example.com?uid=456":         true,
+		"admin@example.com":                              false,
+		"user:":                                          false,
+		"robot:foo@example.com":                          false,
+		"deleted:robot:foo@example.com?uid=123":          false,
+	}
+
+	for member, valid := range cases {
+		_, es := validateIamMember(member, "member")
+		if valid && len(es) > 0 {
+			t.Errorf("Expected %q to be valid, got %v", member, es)
+		}
+		if !valid && len(es) == 0 {
+			t.Errorf("Expected %q to be invalid", member)
+		}
+	}
+}
+
+func TestIamBindingRead_hierarchyPrincipalCase(t *testing.T) {
+	principal := "principalSet://cloudresourcemanager.googleapis.com/projects/123/type/ServiceAccount"
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{principal},
+	})
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{strings.ToLower(principal)},
+	})
+	d.SetId("test-resource/roles/viewer")
+
+	if err := resourceIamBindingRead(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The principal sets are case sensitive, so the differently cased member is a diff rather than the same member.
+	if members := convertStringSet(d.Get("members").(*schema.Set)); !reflect.DeepEqual(members, []string{principal}) {
+		t.Fatalf("Expected members %v, got %v", []string{principal}, members)
+	}
+}
+
+func TestIamBindingRead_deletedMember(t *testing.T) {
+	for _, ignore := range []bool{true, false} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com", "deleted:serviceAccount:foo@example.com?uid=123"},
+		})
+
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":                   "roles/viewer",
+			"members":                []interface{}{"user:admin@example.com", "serviceAccount:foo@example.com"},
+			"ignore_deleted_members": ignore,
+		})
+		d.SetId("test-resource/roles/viewer")
+
+		if err := resourceIamBindingRead(u.newUpdaterFunc())(d, &Config{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := []string{"serviceAccount:foo@example.com", "user:admin@example.com"}
+		if !ignore {
+			expected = []string{"deleted:serviceAccount:foo@example.com?uid=123", "user:admin@example.com"}
+		}
+		members := convertStringSet(d.Get("members").(*schema.Set))
+		sort.Strings(members)
+		if !reflect.DeepEqual(members, expected) {
+			t.Errorf("ignore_deleted_members = %t: expected members %v, got %v", ignore, expected, members)
+		}
+	}
+}
+
+func TestIamBindingRead_drift(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:admin@example.com", "user:foreign@example.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com", "group:admins@example.com"},
+	})
+	d.SetId("test-resource/roles/viewer")
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	if err := resourceIamBindingRead(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := `[WARN]: Members of binding for role "roles/viewer" of test resource "test-resource" drifted: added=[user:foreign@example.com] removed=[group:admins@example.com]`
+	if !strings.Contains(buf.String(), expected) {
+		t.Fatalf("Expected the log to contain %q, got %s", expected, buf.String())
+	}
+
+	members := convertStringSet(d.Get("members").(*schema.Set))
+	sort.Strings(members)
+	if expected := []string{"user:admin@example.com", "user:foreign@example.com"}; !reflect.DeepEqual(members, expected) {
+		t.Fatalf("Expected the live members to be stored in state, got %v", members)
+	}
+}
+
+func TestIamBindingRead_redactedLogs(t *testing.T) {
+	cases := map[bool]string{
+		false: `roles/viewer: [user:admin@example.com, serviceAccount:robot@my-project.iam.gserviceaccount.com, allUsers]`,
+		true:  `roles/viewer: [user:***@example.com, serviceAccount:***@my-project.iam.gserviceaccount.com, allUsers]`,
+	}
+
+	for redact, expected := range cases {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com", "serviceAccount:robot@my-project.iam.gserviceaccount.com", "allUsers"},
+		})
+
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":    "roles/viewer",
+			"members": []interface{}{},
+		})
+		d.SetId("test-resource/roles/viewer")
+
+		var buf bytes.Buffer
+		log.SetOutput(&buf)
+		err := resourceIamBindingRead(u.newUpdaterFunc())(d, &Config{RedactIamLogs: redact})
+		log.SetOutput(os.Stderr)
+		if err != nil {
+			t.Fatalf("redact=%t: unexpected error: %s", redact, err)
+		}
+
+		if !strings.Contains(buf.String(), expected) {
+			t.Fatalf("redact=%t: expected the log to contain %q, got %s", redact, expected, buf.String())
+		}
+		if redact && strings.Contains(buf.String(), "admin@") {
+			t.Fatalf("Expected the members to be redacted, got %s", buf.String())
+		}
+	}
+}
+
+func TestIamBindingRead_redactedDriftLog(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:foreign@example.com", "deleted:user:admin@example.com?uid=123"},
+	})
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com", "group:admins@example.com"},
+	})
+	d.SetId("test-resource/roles/viewer")
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	err := resourceIamBindingRead(u.newUpdaterFunc())(d, &Config{RedactIamLogs: true})
+	log.SetOutput(os.Stderr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := `drifted: added=[deleted:user:***@example.com?uid=123,user:***@example.com] removed=[group:***@example.com,user:***@example.com]`
+	if !strings.Contains(buf.String(), expected) {
+		t.Fatalf("Expected the log to contain %q, got %s", expected, buf.String())
+	}
+	if !strings.Contains(buf.String(), `Member "user:***@example.com" for binding for role "roles/viewer" of test resource "test-resource" has been deleted.`) {
+		t.Fatalf("Expected the deleted member warning to be redacted, got %s", buf.String())
+	}
+	for _, m := range []string{"admin@", "admins@", "foreign@"} {
+		if strings.Contains(buf.String(), m) {
+			t.Fatalf("Expected the members to be redacted, got %s", buf.String())
+		}
+	}
+}
+
+func TestIamBindingRead_noDrift(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:admin@example.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+	d.SetId("test-resource/roles/viewer")
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	if err := resourceIamBindingRead(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if strings.Contains(buf.String(), "drifted") {
+		t.Fatalf("Expected no drift warning, got %s", buf.String())
+	}
+}
+
+func TestIamMemberDelete_lastMember(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:admin@example.com"},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:admin@example.com"},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/browser",
+		Members: []string{},
+	})
+
+	d := schema.TestResourceDataRaw(t, IamMemberBaseSchema, map[string]interface{}{
+		"role":   "roles/viewer",
+		"member": "user:admin@example.com",
+	})
+	d.SetId("test-resource/roles/viewer/user:admin@example.com")
+
+	if err := resourceIamMemberDelete(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// Only the binding emptied by the removal is removed, the others are left as they are.
+	var roles []string
+	for _, b := range u.policy.Bindings {
+		roles = append(roles, b.Role)
+	}
+	if !reflect.DeepEqual(roles, []string{"roles/editor", "roles/browser"}) {
+		t.Fatalf("Expected the binding of roles/viewer to be removed, got bindings of %v", roles)
+	}
+}
+
+func TestGetResourceIamRole(t *testing.T) {
+	cases := []struct {
+		updater  ResourceIamUpdater
+		role     string
+		expand   bool
+		expected string
+	}{
+		{&ProjectIamUpdater{resourceId: "my-project"}, "myCustomRole", true, "projects/my-project/roles/myCustomRole"},
+		{&OrganizationIamUpdater{resourceId: "123456789"}, "myCustomRole", true, "organizations/123456789/roles/myCustomRole"},
+		{&GenericResourceIamUpdater{resource: redisInstanceIamResource, resourceId: "projects/my-project/locations/us-central1/instances/my-instance"}, "myCustomRole", true, "projects/my-project/roles/myCustomRole"},
+		{&ProjectIamUpdater{resourceId: "my-project"}, "roles/viewer", true, "roles/viewer"},
+		{&ProjectIamUpdater{resourceId: "my-project"}, "organizations/123456789/roles/myCustomRole", true, "organizations/123456789/roles/myCustomRole"},
+		{&ProjectIamUpdater{resourceId: "my-project"}, "myCustomRole", false, "myCustomRole"},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":               c.role,
+			"expand_custom_role": c.expand,
+		})
+		role, err := getResourceIamRole(d, c.updater)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.updater.DescribeResource(), err)
+			continue
+		}
+		if role != c.expected {
+			t.Errorf("%s: expected role %q to be %q, got %q", c.updater.DescribeResource(), c.role, c.expected, role)
+		}
+	}
+}
+
+func TestGetResourceIamRole_noCustomRoleParent(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":               "myCustomRole",
+		"expand_custom_role": true,
+	})
+	_, err := getResourceIamRole(d, &FolderIamUpdater{folderId: "folders/1234567"})
+	if err == nil || !strings.Contains(err.Error(), "neither a project nor an organization") {
+		t.Fatalf("Expected an error about the missing project or organization, got %v", err)
+	}
+}
+
+func TestGetResourceIamRole_case(t *testing.T) {
+	cases := []struct {
+		role      string
+		normalize bool
+		expected  string
+		err       bool
+	}{
+		{"roles/viewer", false, "roles/viewer", false},
+		{"roles/compute.storageAdmin", false, "roles/compute.storageAdmin", false},
+		{"projects/my-project/roles/MyCustomRole", false, "projects/my-project/roles/MyCustomRole", false},
+		{"Roles/Viewer", false, "", true},
+		{"roles/Compute.StorageAdmin", false, "", true},
+		{"Roles/Viewer", true, "roles/viewer", false},
+		{"ROLES/Compute.StorageAdmin", true, "roles/compute.storageAdmin", false},
+		{"Organizations/123456789/Roles/MyCustomRole", true, "organizations/123456789/roles/MyCustomRole", false},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+			"role":                c.role,
+			"normalize_role_case": c.normalize,
+		})
+		role, err := getResourceIamRole(d, &ProjectIamUpdater{resourceId: "my-project"})
+		if c.err {
+			if err == nil || !strings.Contains(err.Error(), "normalize_role_case") {
+				t.Errorf("%s: expected an error suggesting normalize_role_case, got %v", c.role, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.role, err)
+			continue
+		}
+		if role != c.expected {
+			t.Errorf("%s: expected role %q, got %q", c.role, c.expected, role)
+		}
+	}
+}
+
+func TestIamBindingCreate_expandCustomRole(t *testing.T) {
+	u := newTestIamUpdater()
+	newUpdater := func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		return &customRoleTestIamUpdater{testIamUpdater: u, custom: &ProjectIamUpdater{resourceId: "my-project"}}, nil
+	}
+
+	d := schema.TestResourceDataRaw(t, iamBindingSchema, map[string]interface{}{
+		"role":               "myCustomRole",
+		"members":            []interface{}{"user:admin@example.com"},
+		"expand_custom_role": true,
+	})
+
+	if err := resourceIamBindingCreate(newUpdater)(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); !bm["projects/my-project/roles/myCustomRole"]["user:admin@example.com"] {
+		t.Fatalf("Expected the custom role to be granted, got %v", bm)
+	}
+	if d.Id() == "" || d.Get("role").(string) != "myCustomRole" {
+		t.Fatalf("Expected the binding to be read with the role as configured, got id %q and role %q", d.Id(), d.Get("role").(string))
+	}
+}
+
+// customRoleTestIamUpdater is a testIamUpdater whose resource belongs to the project of custom.
+type customRoleTestIamUpdater struct {
+	*testIamUpdater
+	custom ResourceIamUpdater
+}
+
+func (u *customRoleTestIamUpdater) GetResourceDescriptor() IamResourceDescriptor {
+	return u.custom.GetResourceDescriptor()
+}
+
+func TestIamMemberRead_deletedMember(t *testing.T) {
+	for _, ignore := range []bool{true, false} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"deleted:serviceAccount:foo@example.com?uid=123"},
+		})
+
+		d := schema.TestResourceDataRaw(t, IamMemberBaseSchema, map[string]interface{}{
+			"role":                   "roles/viewer",
+			"member":                 "serviceAccount:foo@example.com",
+			"ignore_deleted_members": ignore,
+		})
+		d.SetId("test-resource/roles/viewer/serviceAccount:foo@example.com")
+
+		if err := resourceIamMemberRead(u.newUpdaterFunc())(d, &Config{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if ignore && d.Id() == "" {
+			t.Errorf("Expected the deleted member to be kept in state")
+		}
+		if !ignore && d.Id() != "" {
+			t.Errorf("Expected the deleted member to be removed from state")
+		}
+	}
+}
+
+func TestResourceIamBinding_createAndDelete(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:foreign@example.com"},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:admin@example.com"},
+	})
+	r := ResourceIamBinding(map[string]*schema.Schema{}, u.newUpdaterFunc())
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"role":    "roles/viewer",
+		"members": []interface{}{"user:admin@example.com"},
+	})
+	if err := r.Create(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// Creating a binding keeps the members the role is already granted to.
+	bm := rolesToMembersMap(u.policy.Bindings)
+	if len(bm["roles/viewer"]) != 2 || !bm["roles/viewer"]["user:admin@example.com"] || !bm["roles/viewer"]["user:foreign@example.com"] {
+		t.Fatalf("Unexpected members for roles/viewer: %v", bm["roles/viewer"])
+	}
+	if len(bm["roles/editor"]) != 1 || !bm["roles/editor"]["user:admin@example.com"] {
+		t.Fatalf("Unexpected members for roles/editor: %v", bm["roles/editor"])
+	}
+
+	if err := r.Delete(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); len(bm["roles/viewer"]) != 0 {
+		t.Fatalf("Expected the binding to be removed, got members %v", bm["roles/viewer"])
+	}
+}
+
+func TestResourceIamMember_conflictOnFirstWrite(t *testing.T) {
+	u := newTestIamUpdater()
+	u.conflictOnFirstWrite = true
+	r := ResourceIamMember(map[string]*schema.Schema{}, u.newUpdaterFunc())
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"role":   "roles/viewer",
+		"member": "user:admin@example.com",
+	})
+	if err := r.Create(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The read-modify-write is retried after the conflict.
+	if u.getCalls != 2 || u.setCalls != 2 {
+		t.Fatalf("Expected 2 get and 2 set calls, got %d get and %d set calls", u.getCalls, u.setCalls)
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); len(bm["roles/viewer"]) != 1 || !bm["roles/viewer"]["user:admin@example.com"] {
+		t.Fatalf("Unexpected members for roles/viewer: %v", bm["roles/viewer"])
+	}
+}
+
+func TestResourceIamPolicy_create(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:foreign@example.com"},
+	})
+	r := ResourceIamPolicy(map[string]*schema.Schema{}, u.newUpdaterFunc())
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"policy_data": `{"bindings":[{"role":"roles/viewer","members":["user:admin@example.com"]}]}`,
+	})
+	if err := r.Create(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The policy replaces the existing one.
+	bm := rolesToMembersMap(u.policy.Bindings)
+	if len(bm["roles/editor"]) != 0 {
+		t.Fatalf("Expected roles/editor to be removed, got members %v", bm["roles/editor"])
+	}
+	if len(bm["roles/viewer"]) != 1 || !bm["roles/viewer"]["user:admin@example.com"] {
+		t.Fatalf("Unexpected members for roles/viewer: %v", bm["roles/viewer"])
+	}
+}
@@ -0,0 +1,35 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamVertexAIIndexEndpointSchema = map[string]*schema.Schema{
+	"index_endpoint": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var vertexAIIndexEndpointIamResource = GenericIamResource{
+	Type:                "vertex_ai_index_endpoint",
+	Description:         "Vertex AI index endpoint",
+	Api:                 "vertex_ai",
+	PathTemplate:        "projects/{project}/locations/{region}/indexEndpoints/{index_endpoint}",
+	GetIamPolicyMethod:  "POST",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewVertexAIIndexEndpointIamUpdater = NewGenericResourceIamUpdater(vertexAIIndexEndpointIamResource, IamVertexAIIndexEndpointSchema)
+	VertexAIIndexEndpointIdParseFunc   = genericIamIdParseFunc(vertexAIIndexEndpointIamResource)
+)
@@ -0,0 +1,35 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamVertexAIMetadataStoreSchema = map[string]*schema.Schema{
+	"metadata_store": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var vertexAIMetadataStoreIamResource = GenericIamResource{
+	Type:                "vertex_ai_metadata_store",
+	Description:         "Vertex AI metadata store",
+	Api:                 "vertex_ai",
+	PathTemplate:        "projects/{project}/locations/{region}/metadataStores/{metadata_store}",
+	GetIamPolicyMethod:  "POST",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewVertexAIMetadataStoreIamUpdater = NewGenericResourceIamUpdater(vertexAIMetadataStoreIamResource, IamVertexAIMetadataStoreSchema)
+	VertexAIMetadataStoreIdParseFunc   = genericIamIdParseFunc(vertexAIMetadataStoreIamResource)
+)
@@ -0,0 +1,35 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamVertexAITensorboardSchema = map[string]*schema.Schema{
+	"tensorboard": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var vertexAITensorboardIamResource = GenericIamResource{
+	Type:                "vertex_ai_tensorboard",
+	Description:         "Vertex AI Tensorboard",
+	Api:                 "vertex_ai",
+	PathTemplate:        "projects/{project}/locations/{region}/tensorboards/{tensorboard}",
+	GetIamPolicyMethod:  "POST",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewVertexAITensorboardIamUpdater = NewGenericResourceIamUpdater(vertexAITensorboardIamResource, IamVertexAITensorboardSchema)
+	VertexAITensorboardIdParseFunc   = genericIamIdParseFunc(vertexAITensorboardIamResource)
+)
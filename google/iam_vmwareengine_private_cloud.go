@@ -0,0 +1,34 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamVmwareenginePrivateCloudSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"private_cloud": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var vmwareenginePrivateCloudIamResource = GenericIamResource{
+	Type:                "vmwareengine_private_cloud",
+	Description:         "VMware Engine private cloud",
+	Api:                 "vmwareengine",
+	PathTemplate:        "projects/{project}/locations/{location}/privateClouds/{private_cloud}",
+	IamMayBeUnsupported: true,
+}
+
+var (
+	NewVmwareenginePrivateCloudIamUpdater = NewGenericResourceIamUpdater(vmwareenginePrivateCloudIamResource, IamVmwareenginePrivateCloudSchema)
+	VmwareenginePrivateCloudIdParseFunc   = genericIamIdParseFunc(vmwareenginePrivateCloudIamResource)
+)
@@ -0,0 +1,36 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamWorkloadIdentityPoolSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "global",
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+	"workload_identity_pool_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+var iamWorkloadIdentityPoolIamResource = GenericIamResource{
+	Type:               "iam_workload_identity_pool",
+	Description:        "workload identity pool",
+	Api:                "iam",
+	ApiVersion:         "v1",
+	PathTemplate:       "projects/{project}/locations/{location}/workloadIdentityPools/{workload_identity_pool_id}",
+	GetIamPolicyMethod: "POST",
+}
+
+var (
+	NewIamWorkloadIdentityPoolIamUpdater = NewGenericResourceIamUpdater(iamWorkloadIdentityPoolIamResource, IamWorkloadIdentityPoolSchema)
+	IamWorkloadIdentityPoolIdParseFunc   = genericIamIdParseFunc(iamWorkloadIdentityPoolIamResource)
+)
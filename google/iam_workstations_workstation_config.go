@@ -0,0 +1,38 @@
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var IamWorkstationsWorkstationConfigSchema = map[string]*schema.Schema{
+	"cluster": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"config": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var workstationsWorkstationConfigIamResource = GenericIamResource{
+	Type:         "workstations_workstation_config",
+	Description:  "Cloud Workstations configuration",
+	Api:          "workstations",
+	PathTemplate: "projects/{project}/locations/{location}/workstationClusters/{cluster}/workstationConfigs/{config}",
+}
+
+var (
+	NewWorkstationsWorkstationConfigIamUpdater = NewGenericResourceIamUpdater(workstationsWorkstationConfigIamResource, IamWorkstationsWorkstationConfigSchema)
+	WorkstationsWorkstationConfigIdParseFunc   = genericIamIdParseFunc(workstationsWorkstationConfigIamResource)
+)
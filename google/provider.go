@@ -3,6 +3,8 @@ package google
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,7 +16,7 @@ var mutexKV = mutexkv.NewMutexKV()
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"credentials": &schema.Schema{
 				Type:     schema.TypeString,
@@ -46,6 +48,54 @@ func Provider() terraform.ResourceProvider {
 					"CLOUDSDK_COMPUTE_REGION",
 				}, nil),
 			},
+
+			"user_agent_suffix": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_USER_AGENT_SUFFIX", nil),
+			},
+
+			"redact_iam_logs": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_REDACT_IAM_LOGS", false),
+			},
+
+			"iam_binding_authoritative_on_create": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_IAM_BINDING_AUTHORITATIVE_ON_CREATE", false),
+			},
+
+			"iam_read_after_create_wait": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_IAM_READ_AFTER_CREATE_WAIT", "5s"),
+			},
+
+			"iam_max_total_retry_duration": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_IAM_MAX_TOTAL_RETRY_DURATION", ""),
+			},
+
+			"report_redundant_iam_grants": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_REPORT_REDUNDANT_IAM_GRANTS", false),
+			},
+
+			"skip_iam_parent_check": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_SKIP_IAM_PARENT_CHECK", false),
+			},
+
+			"detect_duplicate_iam_bindings": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GOOGLE_DETECT_DUPLICATE_IAM_BINDINGS", false),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -65,95 +115,246 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"google_bigquery_dataset":                      resourceBigQueryDataset(),
-			"google_bigquery_table":                        resourceBigQueryTable(),
-			"google_bigtable_instance":                     resourceBigtableInstance(),
-			"google_bigtable_table":                        resourceBigtableTable(),
-			"google_compute_autoscaler":                    resourceComputeAutoscaler(),
-			"google_compute_address":                       resourceComputeAddress(),
-			"google_compute_backend_bucket":                resourceComputeBackendBucket(),
-			"google_compute_backend_service":               resourceComputeBackendService(),
-			"google_compute_disk":                          resourceComputeDisk(),
-			"google_compute_snapshot":                      resourceComputeSnapshot(),
-			"google_compute_firewall":                      resourceComputeFirewall(),
-			"google_compute_forwarding_rule":               resourceComputeForwardingRule(),
-			"google_compute_global_address":                resourceComputeGlobalAddress(),
-			"google_compute_global_forwarding_rule":        resourceComputeGlobalForwardingRule(),
-			"google_compute_health_check":                  resourceComputeHealthCheck(),
-			"google_compute_http_health_check":             resourceComputeHttpHealthCheck(),
-			"google_compute_https_health_check":            resourceComputeHttpsHealthCheck(),
-			"google_compute_image":                         resourceComputeImage(),
-			"google_compute_instance":                      resourceComputeInstance(),
-			"google_compute_instance_group":                resourceComputeInstanceGroup(),
-			"google_compute_instance_group_manager":        resourceComputeInstanceGroupManager(),
-			"google_compute_instance_template":             resourceComputeInstanceTemplate(),
-			"google_compute_network":                       resourceComputeNetwork(),
-			"google_compute_network_peering":               resourceComputeNetworkPeering(),
-			"google_compute_project_metadata":              resourceComputeProjectMetadata(),
-			"google_compute_project_metadata_item":         resourceComputeProjectMetadataItem(),
-			"google_compute_region_autoscaler":             resourceComputeRegionAutoscaler(),
-			"google_compute_region_backend_service":        resourceComputeRegionBackendService(),
-			"google_compute_region_instance_group_manager": resourceComputeRegionInstanceGroupManager(),
-			"google_compute_route":                         resourceComputeRoute(),
-			"google_compute_router":                        resourceComputeRouter(),
-			"google_compute_router_interface":              resourceComputeRouterInterface(),
-			"google_compute_router_peer":                   resourceComputeRouterPeer(),
-			"google_compute_shared_vpc_host_project":       resourceComputeSharedVpcHostProject(),
-			"google_compute_shared_vpc_service_project":    resourceComputeSharedVpcServiceProject(),
-			"google_compute_ssl_certificate":               resourceComputeSslCertificate(),
-			"google_compute_subnetwork":                    resourceComputeSubnetwork(),
-			"google_compute_target_http_proxy":             resourceComputeTargetHttpProxy(),
-			"google_compute_target_https_proxy":            resourceComputeTargetHttpsProxy(),
-			"google_compute_target_tcp_proxy":              resourceComputeTargetTcpProxy(),
-			"google_compute_target_ssl_proxy":              resourceComputeTargetSslProxy(),
-			"google_compute_target_pool":                   resourceComputeTargetPool(),
-			"google_compute_url_map":                       resourceComputeUrlMap(),
-			"google_compute_vpn_gateway":                   resourceComputeVpnGateway(),
-			"google_compute_vpn_tunnel":                    resourceComputeVpnTunnel(),
-			"google_container_cluster":                     resourceContainerCluster(),
-			"google_container_node_pool":                   resourceContainerNodePool(),
-			"google_dataproc_cluster":                      resourceDataprocCluster(),
-			"google_dataproc_job":                          resourceDataprocJob(),
-			"google_dns_managed_zone":                      resourceDnsManagedZone(),
-			"google_dns_record_set":                        resourceDnsRecordSet(),
-			"google_folder":                                resourceGoogleFolder(),
-			"google_folder_iam_policy":                     ResourceIamPolicy(IamFolderSchema, NewFolderIamUpdater),
-			"google_logging_billing_account_sink":          resourceLoggingBillingAccountSink(),
-			"google_logging_folder_sink":                   resourceLoggingFolderSink(),
-			"google_logging_project_sink":                  resourceLoggingProjectSink(),
-			"google_kms_key_ring":                          resourceKmsKeyRing(),
-			"google_kms_crypto_key":                        resourceKmsCryptoKey(),
-			"google_sourcerepo_repository":                 resourceSourceRepoRepository(),
-			"google_spanner_instance":                      resourceSpannerInstance(),
-			"google_spanner_database":                      resourceSpannerDatabase(),
-			"google_sql_database":                          resourceSqlDatabase(),
-			"google_sql_database_instance":                 resourceSqlDatabaseInstance(),
-			"google_sql_user":                              resourceSqlUser(),
-			"google_organization_iam_binding":              ResourceIamBinding(IamOrganizationSchema, NewOrganizationIamUpdater),
-			"google_organization_iam_custom_role":          resourceGoogleOrganizationIamCustomRole(),
-			"google_organization_iam_member":               ResourceIamMember(IamOrganizationSchema, NewOrganizationIamUpdater),
-			"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
-			"google_project":                               resourceGoogleProject(),
-			"google_project_iam_policy":                    resourceGoogleProjectIamPolicy(),
-			"google_project_iam_binding":                   ResourceIamBinding(IamProjectSchema, NewProjectIamUpdater),
-			"google_project_iam_member":                    ResourceIamMember(IamProjectSchema, NewProjectIamUpdater),
-			"google_project_service":                       resourceGoogleProjectService(),
-			"google_project_iam_custom_role":               resourceGoogleProjectIamCustomRole(),
-			"google_project_services":                      resourceGoogleProjectServices(),
-			"google_pubsub_topic":                          resourcePubsubTopic(),
-			"google_pubsub_subscription":                   resourcePubsubSubscription(),
-			"google_runtimeconfig_config":                  resourceRuntimeconfigConfig(),
-			"google_runtimeconfig_variable":                resourceRuntimeconfigVariable(),
-			"google_service_account":                       resourceGoogleServiceAccount(),
-			"google_service_account_key":                   resourceGoogleServiceAccountKey(),
-			"google_storage_bucket":                        resourceStorageBucket(),
-			"google_storage_bucket_acl":                    resourceStorageBucketAcl(),
-			"google_storage_bucket_object":                 resourceStorageBucketObject(),
-			"google_storage_object_acl":                    resourceStorageObjectAcl(),
+			"google_alloydb_cluster_iam_binding":                              ResourceIamBindingWithImport(IamAlloyDBClusterSchema, NewAlloyDBClusterIamUpdater, AlloyDBClusterIdParseFunc),
+			"google_alloydb_cluster_iam_member":                               ResourceIamMember(IamAlloyDBClusterSchema, NewAlloyDBClusterIamUpdater),
+			"google_alloydb_cluster_iam_policy":                               ResourceIamPolicyWithImport(IamAlloyDBClusterSchema, NewAlloyDBClusterIamUpdater, AlloyDBClusterIdParseFunc),
+			"google_apigee_organization_iam_binding":                          ResourceIamBindingWithImport(IamApigeeOrganizationSchema, NewApigeeOrganizationIamUpdater, ApigeeOrganizationIdParseFunc),
+			"google_apigee_organization_iam_member":                           ResourceIamMember(IamApigeeOrganizationSchema, NewApigeeOrganizationIamUpdater),
+			"google_apigee_organization_iam_policy":                           ResourceIamPolicyWithImport(IamApigeeOrganizationSchema, NewApigeeOrganizationIamUpdater, ApigeeOrganizationIdParseFunc),
+			"google_apphub_application_iam_binding":                           ResourceIamBindingWithImport(IamAppHubApplicationSchema, NewAppHubApplicationIamUpdater, AppHubApplicationIdParseFunc),
+			"google_apphub_application_iam_member":                            ResourceIamMember(IamAppHubApplicationSchema, NewAppHubApplicationIamUpdater),
+			"google_apphub_application_iam_policy":                            ResourceIamPolicyWithImport(IamAppHubApplicationSchema, NewAppHubApplicationIamUpdater, AppHubApplicationIdParseFunc),
+			"google_assured_workloads_workload_iam_binding":                   ResourceIamBindingWithImport(IamAssuredWorkloadsWorkloadSchema, NewAssuredWorkloadsWorkloadIamUpdater, AssuredWorkloadsWorkloadIdParseFunc),
+			"google_assured_workloads_workload_iam_member":                    ResourceIamMember(IamAssuredWorkloadsWorkloadSchema, NewAssuredWorkloadsWorkloadIamUpdater),
+			"google_assured_workloads_workload_iam_policy":                    ResourceIamPolicyWithImport(IamAssuredWorkloadsWorkloadSchema, NewAssuredWorkloadsWorkloadIamUpdater, AssuredWorkloadsWorkloadIdParseFunc),
+			"google_batch_job_iam_binding":                                    ResourceIamBindingWithImport(IamBatchJobSchema, NewBatchJobIamUpdater, BatchJobIdParseFunc),
+			"google_batch_job_iam_member":                                     ResourceIamMember(IamBatchJobSchema, NewBatchJobIamUpdater),
+			"google_batch_job_iam_policy":                                     ResourceIamPolicyWithImport(IamBatchJobSchema, NewBatchJobIamUpdater, BatchJobIdParseFunc),
+			"google_beyondcorp_app_connector_iam_binding":                     ResourceIamBindingWithImport(IamBeyondcorpAppConnectorSchema, NewBeyondcorpAppConnectorIamUpdater, BeyondcorpAppConnectorIdParseFunc),
+			"google_beyondcorp_app_connector_iam_member":                      ResourceIamMember(IamBeyondcorpAppConnectorSchema, NewBeyondcorpAppConnectorIamUpdater),
+			"google_beyondcorp_app_connector_iam_policy":                      ResourceIamPolicyWithImport(IamBeyondcorpAppConnectorSchema, NewBeyondcorpAppConnectorIamUpdater, BeyondcorpAppConnectorIdParseFunc),
+			"google_bigquery_dataset":                                         resourceBigQueryDataset(),
+			"google_bigquery_table":                                           resourceBigQueryTable(),
+			"google_bigtable_instance":                                        resourceBigtableInstance(),
+			"google_bigtable_table":                                           resourceBigtableTable(),
+			"google_certificate_manager_certificate_iam_binding":              ResourceIamBindingWithImport(IamCertificateManagerCertificateSchema, NewCertificateManagerCertificateIamUpdater, CertificateManagerCertificateIdParseFunc),
+			"google_certificate_manager_certificate_iam_member":               ResourceIamMember(IamCertificateManagerCertificateSchema, NewCertificateManagerCertificateIamUpdater),
+			"google_certificate_manager_certificate_iam_policy":               ResourceIamPolicyWithImport(IamCertificateManagerCertificateSchema, NewCertificateManagerCertificateIamUpdater, CertificateManagerCertificateIdParseFunc),
+			"google_cloudbuild_worker_pool_iam_binding":                       ResourceIamBindingWithImport(IamCloudBuildWorkerPoolSchema, NewCloudBuildWorkerPoolIamUpdater, CloudBuildWorkerPoolIdParseFunc),
+			"google_cloudbuild_worker_pool_iam_member":                        ResourceIamMember(IamCloudBuildWorkerPoolSchema, NewCloudBuildWorkerPoolIamUpdater),
+			"google_cloudbuild_worker_pool_iam_policy":                        ResourceIamPolicyWithImport(IamCloudBuildWorkerPoolSchema, NewCloudBuildWorkerPoolIamUpdater, CloudBuildWorkerPoolIdParseFunc),
+			"google_clouddeploy_target_iam_binding":                           ResourceIamBindingWithImport(IamCloudDeployTargetSchema, NewCloudDeployTargetIamUpdater, CloudDeployTargetIdParseFunc),
+			"google_clouddeploy_target_iam_member":                            ResourceIamMember(IamCloudDeployTargetSchema, NewCloudDeployTargetIamUpdater),
+			"google_clouddeploy_target_iam_policy":                            ResourceIamPolicyWithImport(IamCloudDeployTargetSchema, NewCloudDeployTargetIamUpdater, CloudDeployTargetIdParseFunc),
+			"google_compute_autoscaler":                                       resourceComputeAutoscaler(),
+			"google_compute_address":                                          resourceComputeAddress(),
+			"google_compute_backend_bucket":                                   resourceComputeBackendBucket(),
+			"google_compute_backend_service":                                  resourceComputeBackendService(),
+			"google_compute_disk":                                             resourceComputeDisk(),
+			"google_compute_reservation_iam_binding":                          ResourceIamBindingWithImport(IamComputeReservationSchema, NewComputeReservationIamUpdater, ComputeReservationIdParseFunc),
+			"google_compute_reservation_iam_member":                           ResourceIamMember(IamComputeReservationSchema, NewComputeReservationIamUpdater),
+			"google_compute_reservation_iam_policy":                           ResourceIamPolicyWithImport(IamComputeReservationSchema, NewComputeReservationIamUpdater, ComputeReservationIdParseFunc),
+			"google_compute_snapshot":                                         resourceComputeSnapshot(),
+			"google_compute_snapshot_iam_binding":                             ResourceIamBindingWithImport(IamComputeSnapshotSchema, NewComputeSnapshotIamUpdater, ComputeSnapshotIdParseFunc),
+			"google_compute_snapshot_iam_member":                              ResourceIamMember(IamComputeSnapshotSchema, NewComputeSnapshotIamUpdater),
+			"google_compute_snapshot_iam_policy":                              ResourceIamPolicyWithImport(IamComputeSnapshotSchema, NewComputeSnapshotIamUpdater, ComputeSnapshotIdParseFunc),
+			"google_compute_firewall":                                         resourceComputeFirewall(),
+			"google_compute_forwarding_rule":                                  resourceComputeForwardingRule(),
+			"google_compute_global_address":                                   resourceComputeGlobalAddress(),
+			"google_compute_global_forwarding_rule":                           resourceComputeGlobalForwardingRule(),
+			"google_compute_health_check":                                     resourceComputeHealthCheck(),
+			"google_compute_http_health_check":                                resourceComputeHttpHealthCheck(),
+			"google_compute_https_health_check":                               resourceComputeHttpsHealthCheck(),
+			"google_compute_image":                                            resourceComputeImage(),
+			"google_compute_instance":                                         resourceComputeInstance(),
+			"google_compute_instance_group":                                   resourceComputeInstanceGroup(),
+			"google_compute_instance_group_manager":                           resourceComputeInstanceGroupManager(),
+			"google_compute_instance_template":                                resourceComputeInstanceTemplate(),
+			"google_compute_machine_image_iam_binding":                        ResourceIamBindingWithImport(IamComputeMachineImageSchema, NewComputeMachineImageIamUpdater, ComputeMachineImageIdParseFunc),
+			"google_compute_machine_image_iam_member":                         ResourceIamMember(IamComputeMachineImageSchema, NewComputeMachineImageIamUpdater),
+			"google_compute_machine_image_iam_policy":                         ResourceIamPolicyWithImport(IamComputeMachineImageSchema, NewComputeMachineImageIamUpdater, ComputeMachineImageIdParseFunc),
+			"google_compute_network":                                          resourceComputeNetwork(),
+			"google_compute_network_peering":                                  resourceComputeNetworkPeering(),
+			"google_compute_project_metadata":                                 resourceComputeProjectMetadata(),
+			"google_compute_project_metadata_item":                            resourceComputeProjectMetadataItem(),
+			"google_compute_region_autoscaler":                                resourceComputeRegionAutoscaler(),
+			"google_compute_region_backend_service":                           resourceComputeRegionBackendService(),
+			"google_compute_region_instance_group_manager":                    resourceComputeRegionInstanceGroupManager(),
+			"google_compute_route":                                            resourceComputeRoute(),
+			"google_compute_router":                                           resourceComputeRouter(),
+			"google_compute_router_interface":                                 resourceComputeRouterInterface(),
+			"google_compute_router_peer":                                      resourceComputeRouterPeer(),
+			"google_compute_shared_vpc_host_project":                          resourceComputeSharedVpcHostProject(),
+			"google_compute_shared_vpc_service_project":                       resourceComputeSharedVpcServiceProject(),
+			"google_compute_ssl_certificate":                                  resourceComputeSslCertificate(),
+			"google_compute_subnetwork":                                       resourceComputeSubnetwork(),
+			"google_compute_target_http_proxy":                                resourceComputeTargetHttpProxy(),
+			"google_compute_target_https_proxy":                               resourceComputeTargetHttpsProxy(),
+			"google_compute_target_tcp_proxy":                                 resourceComputeTargetTcpProxy(),
+			"google_compute_target_ssl_proxy":                                 resourceComputeTargetSslProxy(),
+			"google_compute_target_pool":                                      resourceComputeTargetPool(),
+			"google_compute_url_map":                                          resourceComputeUrlMap(),
+			"google_compute_vpn_gateway":                                      resourceComputeVpnGateway(),
+			"google_compute_vpn_tunnel":                                       resourceComputeVpnTunnel(),
+			"google_container_cluster":                                        resourceContainerCluster(),
+			"google_container_cluster_iam_binding":                            ResourceIamBindingWithImport(IamContainerClusterSchema, NewContainerClusterIamUpdater, ContainerClusterIdParseFunc),
+			"google_container_cluster_iam_member":                             ResourceIamMember(IamContainerClusterSchema, NewContainerClusterIamUpdater),
+			"google_container_cluster_iam_policy":                             ResourceIamPolicyWithImport(IamContainerClusterSchema, NewContainerClusterIamUpdater, ContainerClusterIdParseFunc),
+			"google_container_node_pool":                                      resourceContainerNodePool(),
+			"google_data_fusion_instance_iam_binding":                         ResourceIamBindingWithImport(IamDataFusionInstanceSchema, NewDataFusionInstanceIamUpdater, DataFusionInstanceIdParseFunc),
+			"google_data_fusion_instance_iam_member":                          ResourceIamMember(IamDataFusionInstanceSchema, NewDataFusionInstanceIamUpdater),
+			"google_data_fusion_instance_iam_policy":                          ResourceIamPolicyWithImport(IamDataFusionInstanceSchema, NewDataFusionInstanceIamUpdater, DataFusionInstanceIdParseFunc),
+			"google_dataform_repository_iam_binding":                          ResourceIamBindingWithImport(IamDataformRepositorySchema, NewDataformRepositoryIamUpdater, DataformRepositoryIdParseFunc),
+			"google_dataform_repository_iam_member":                           ResourceIamMember(IamDataformRepositorySchema, NewDataformRepositoryIamUpdater),
+			"google_dataform_repository_iam_policy":                           ResourceIamPolicyWithImport(IamDataformRepositorySchema, NewDataformRepositoryIamUpdater, DataformRepositoryIdParseFunc),
+			"google_dataproc_cluster":                                         resourceDataprocCluster(),
+			"google_dataproc_job":                                             resourceDataprocJob(),
+			"google_dataproc_metastore_service_iam_binding":                   ResourceIamBindingWithImport(IamDataprocMetastoreServiceSchema, NewDataprocMetastoreServiceIamUpdater, DataprocMetastoreServiceIdParseFunc),
+			"google_dataproc_metastore_service_iam_member":                    ResourceIamMember(IamDataprocMetastoreServiceSchema, NewDataprocMetastoreServiceIamUpdater),
+			"google_dataproc_metastore_service_iam_policy":                    ResourceIamPolicyWithImport(IamDataprocMetastoreServiceSchema, NewDataprocMetastoreServiceIamUpdater, DataprocMetastoreServiceIdParseFunc),
+			"google_developer_connect_connection_iam_binding":                 ResourceIamBindingWithImport(IamDeveloperConnectConnectionSchema, NewDeveloperConnectConnectionIamUpdater, DeveloperConnectConnectionIdParseFunc),
+			"google_developer_connect_connection_iam_member":                  ResourceIamMember(IamDeveloperConnectConnectionSchema, NewDeveloperConnectConnectionIamUpdater),
+			"google_developer_connect_connection_iam_policy":                  ResourceIamPolicyWithImport(IamDeveloperConnectConnectionSchema, NewDeveloperConnectConnectionIamUpdater, DeveloperConnectConnectionIdParseFunc),
+			"google_discovery_engine_data_store_iam_binding":                  ResourceIamBindingWithImport(IamDiscoveryEngineDataStoreSchema, NewDiscoveryEngineDataStoreIamUpdater, DiscoveryEngineDataStoreIdParseFunc),
+			"google_discovery_engine_data_store_iam_member":                   ResourceIamMember(IamDiscoveryEngineDataStoreSchema, NewDiscoveryEngineDataStoreIamUpdater),
+			"google_discovery_engine_data_store_iam_policy":                   ResourceIamPolicyWithImport(IamDiscoveryEngineDataStoreSchema, NewDiscoveryEngineDataStoreIamUpdater, DiscoveryEngineDataStoreIdParseFunc),
+			"google_discovery_engine_engine_iam_binding":                      ResourceIamBindingWithImport(IamDiscoveryEngineEngineSchema, NewDiscoveryEngineEngineIamUpdater, DiscoveryEngineEngineIdParseFunc),
+			"google_discovery_engine_engine_iam_member":                       ResourceIamMember(IamDiscoveryEngineEngineSchema, NewDiscoveryEngineEngineIamUpdater),
+			"google_discovery_engine_engine_iam_policy":                       ResourceIamPolicyWithImport(IamDiscoveryEngineEngineSchema, NewDiscoveryEngineEngineIamUpdater, DiscoveryEngineEngineIdParseFunc),
+			"google_dns_managed_zone":                                         resourceDnsManagedZone(),
+			"google_dns_record_set":                                           resourceDnsRecordSet(),
+			"google_edgecontainer_cluster_iam_binding":                        ResourceIamBindingWithImport(IamEdgeContainerClusterSchema, NewEdgeContainerClusterIamUpdater, EdgeContainerClusterIdParseFunc),
+			"google_edgecontainer_cluster_iam_member":                         ResourceIamMember(IamEdgeContainerClusterSchema, NewEdgeContainerClusterIamUpdater),
+			"google_edgecontainer_cluster_iam_policy":                         ResourceIamPolicyWithImport(IamEdgeContainerClusterSchema, NewEdgeContainerClusterIamUpdater, EdgeContainerClusterIdParseFunc),
+			"google_eventarc_channel_iam_binding":                             ResourceIamBindingWithImport(IamEventarcChannelSchema, NewEventarcChannelIamUpdater, EventarcChannelIdParseFunc),
+			"google_eventarc_channel_iam_member":                              ResourceIamMember(IamEventarcChannelSchema, NewEventarcChannelIamUpdater),
+			"google_eventarc_channel_iam_policy":                              ResourceIamPolicyWithImport(IamEventarcChannelSchema, NewEventarcChannelIamUpdater, EventarcChannelIdParseFunc),
+			"google_firestore_database_iam_binding":                           ResourceIamBindingWithImport(IamFirestoreDatabaseSchema, NewFirestoreDatabaseIamUpdater, FirestoreDatabaseIdParseFunc),
+			"google_firestore_database_iam_member":                            ResourceIamMember(IamFirestoreDatabaseSchema, NewFirestoreDatabaseIamUpdater),
+			"google_firestore_database_iam_policy":                            ResourceIamPolicyWithImport(IamFirestoreDatabaseSchema, NewFirestoreDatabaseIamUpdater, FirestoreDatabaseIdParseFunc),
+			"google_folder":                                                   resourceGoogleFolder(),
+			"google_folder_iam_policy":                                        ResourceIamPolicyWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
+			"google_gemini_gemini_gcp_enablement_setting_iam_binding":         ResourceIamBindingWithImport(IamGeminiGcpEnablementSettingSchema, NewGeminiGcpEnablementSettingIamUpdater, GeminiGcpEnablementSettingIdParseFunc),
+			"google_gemini_gemini_gcp_enablement_setting_iam_member":          ResourceIamMember(IamGeminiGcpEnablementSettingSchema, NewGeminiGcpEnablementSettingIamUpdater),
+			"google_gemini_gemini_gcp_enablement_setting_iam_policy":          ResourceIamPolicyWithImport(IamGeminiGcpEnablementSettingSchema, NewGeminiGcpEnablementSettingIamUpdater, GeminiGcpEnablementSettingIdParseFunc),
+			"google_gke_backup_restore_plan_iam_binding":                      ResourceIamBindingWithImport(IamGkeBackupRestorePlanSchema, NewGkeBackupRestorePlanIamUpdater, GkeBackupRestorePlanIdParseFunc),
+			"google_gke_backup_restore_plan_iam_member":                       ResourceIamMember(IamGkeBackupRestorePlanSchema, NewGkeBackupRestorePlanIamUpdater),
+			"google_gke_backup_restore_plan_iam_policy":                       ResourceIamPolicyWithImport(IamGkeBackupRestorePlanSchema, NewGkeBackupRestorePlanIamUpdater, GkeBackupRestorePlanIdParseFunc),
+			"google_gke_hub_feature_iam_binding":                              ResourceIamBindingWithImport(IamGkeHubFeatureSchema, NewGkeHubFeatureIamUpdater, GkeHubFeatureIdParseFunc),
+			"google_gke_hub_feature_iam_member":                               ResourceIamMember(IamGkeHubFeatureSchema, NewGkeHubFeatureIamUpdater),
+			"google_gke_hub_feature_iam_policy":                               ResourceIamPolicyWithImport(IamGkeHubFeatureSchema, NewGkeHubFeatureIamUpdater, GkeHubFeatureIdParseFunc),
+			"google_gke_hub_scope_iam_binding":                                ResourceIamBindingWithImport(IamGkeHubScopeSchema, NewGkeHubScopeIamUpdater, GkeHubScopeIdParseFunc),
+			"google_gke_hub_scope_iam_member":                                 ResourceIamMember(IamGkeHubScopeSchema, NewGkeHubScopeIamUpdater),
+			"google_gke_hub_scope_iam_policy":                                 ResourceIamPolicyWithImport(IamGkeHubScopeSchema, NewGkeHubScopeIamUpdater, GkeHubScopeIdParseFunc),
+			"google_gke_multi_cloud_attached_cluster_iam_binding":             ResourceIamBindingWithImport(IamGkeMultiCloudAttachedClusterSchema, NewGkeMultiCloudAttachedClusterIamUpdater, GkeMultiCloudAttachedClusterIdParseFunc),
+			"google_gke_multi_cloud_attached_cluster_iam_member":              ResourceIamMember(IamGkeMultiCloudAttachedClusterSchema, NewGkeMultiCloudAttachedClusterIamUpdater),
+			"google_gke_multi_cloud_attached_cluster_iam_policy":              ResourceIamPolicyWithImport(IamGkeMultiCloudAttachedClusterSchema, NewGkeMultiCloudAttachedClusterIamUpdater, GkeMultiCloudAttachedClusterIdParseFunc),
+			"google_iam_member_cleanup":                                       resourceGoogleIamMemberCleanup(),
+			"google_iam_workload_identity_pool_iam_binding":                   ResourceIamBindingWithImport(IamWorkloadIdentityPoolSchema, NewIamWorkloadIdentityPoolIamUpdater, IamWorkloadIdentityPoolIdParseFunc),
+			"google_iam_workload_identity_pool_iam_member":                    ResourceIamMember(IamWorkloadIdentityPoolSchema, NewIamWorkloadIdentityPoolIamUpdater),
+			"google_iam_workload_identity_pool_iam_policy":                    ResourceIamPolicyWithImport(IamWorkloadIdentityPoolSchema, NewIamWorkloadIdentityPoolIamUpdater, IamWorkloadIdentityPoolIdParseFunc),
+			"google_integration_connectors_connection_iam_binding":            ResourceIamBindingWithImport(IamIntegrationConnectorsConnectionSchema, NewIntegrationConnectorsConnectionIamUpdater, IntegrationConnectorsConnectionIdParseFunc),
+			"google_integration_connectors_connection_iam_member":             ResourceIamMember(IamIntegrationConnectorsConnectionSchema, NewIntegrationConnectorsConnectionIamUpdater),
+			"google_integration_connectors_connection_iam_policy":             ResourceIamPolicyWithImport(IamIntegrationConnectorsConnectionSchema, NewIntegrationConnectorsConnectionIamUpdater, IntegrationConnectorsConnectionIdParseFunc),
+			"google_logging_billing_account_sink":                             resourceLoggingBillingAccountSink(),
+			"google_logging_folder_sink":                                      resourceLoggingFolderSink(),
+			"google_logging_project_sink":                                     resourceLoggingProjectSink(),
+			"google_kms_key_ring":                                             resourceKmsKeyRing(),
+			"google_kms_crypto_key":                                           resourceKmsCryptoKey(),
+			"google_kms_key_ring_iam_binding":                                 ResourceIamBindingWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KmsKeyRingIdParseFunc),
+			"google_kms_key_ring_iam_member":                                  ResourceIamMember(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater),
+			"google_kms_key_ring_iam_policy":                                  ResourceIamPolicyWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KmsKeyRingIdParseFunc),
+			"google_managed_kafka_cluster_iam_binding":                        ResourceIamBindingWithImport(IamManagedKafkaClusterSchema, NewManagedKafkaClusterIamUpdater, ManagedKafkaClusterIdParseFunc),
+			"google_managed_kafka_cluster_iam_member":                         ResourceIamMember(IamManagedKafkaClusterSchema, NewManagedKafkaClusterIamUpdater),
+			"google_managed_kafka_cluster_iam_policy":                         ResourceIamPolicyWithImport(IamManagedKafkaClusterSchema, NewManagedKafkaClusterIamUpdater, ManagedKafkaClusterIdParseFunc),
+			"google_network_connectivity_hub_iam_binding":                     ResourceIamBindingWithImport(IamNetworkConnectivityHubSchema, NewNetworkConnectivityHubIamUpdater, NetworkConnectivityHubIdParseFunc),
+			"google_network_connectivity_hub_iam_member":                      ResourceIamMember(IamNetworkConnectivityHubSchema, NewNetworkConnectivityHubIamUpdater),
+			"google_network_connectivity_hub_iam_policy":                      ResourceIamPolicyWithImport(IamNetworkConnectivityHubSchema, NewNetworkConnectivityHubIamUpdater, NetworkConnectivityHubIdParseFunc),
+			"google_network_security_client_tls_policy_iam_binding":           ResourceIamBindingWithImport(IamNetworkSecurityClientTlsPolicySchema, NewNetworkSecurityClientTlsPolicyIamUpdater, NetworkSecurityClientTlsPolicyIdParseFunc),
+			"google_network_security_client_tls_policy_iam_member":            ResourceIamMember(IamNetworkSecurityClientTlsPolicySchema, NewNetworkSecurityClientTlsPolicyIamUpdater),
+			"google_network_security_client_tls_policy_iam_policy":            ResourceIamPolicyWithImport(IamNetworkSecurityClientTlsPolicySchema, NewNetworkSecurityClientTlsPolicyIamUpdater, NetworkSecurityClientTlsPolicyIdParseFunc),
+			"google_oracle_database_cloud_exadata_infrastructure_iam_binding": ResourceIamBindingWithImport(IamOracleDatabaseCloudExadataInfrastructureSchema, NewOracleDatabaseCloudExadataInfrastructureIamUpdater, OracleDatabaseCloudExadataInfrastructureIdParseFunc),
+			"google_oracle_database_cloud_exadata_infrastructure_iam_member":  ResourceIamMember(IamOracleDatabaseCloudExadataInfrastructureSchema, NewOracleDatabaseCloudExadataInfrastructureIamUpdater),
+			"google_oracle_database_cloud_exadata_infrastructure_iam_policy":  ResourceIamPolicyWithImport(IamOracleDatabaseCloudExadataInfrastructureSchema, NewOracleDatabaseCloudExadataInfrastructureIamUpdater, OracleDatabaseCloudExadataInfrastructureIdParseFunc),
+			"google_parallelstore_instance_iam_binding":                       ResourceIamBindingWithImport(IamParallelstoreInstanceSchema, NewParallelstoreInstanceIamUpdater, ParallelstoreInstanceIdParseFunc),
+			"google_parallelstore_instance_iam_member":                        ResourceIamMember(IamParallelstoreInstanceSchema, NewParallelstoreInstanceIamUpdater),
+			"google_parallelstore_instance_iam_policy":                        ResourceIamPolicyWithImport(IamParallelstoreInstanceSchema, NewParallelstoreInstanceIamUpdater, ParallelstoreInstanceIdParseFunc),
+			"google_pubsub_lite_reservation_iam_binding":                      ResourceIamBindingWithImport(IamPubsubLiteReservationSchema, NewPubsubLiteReservationIamUpdater, PubsubLiteReservationIdParseFunc),
+			"google_pubsub_lite_reservation_iam_member":                       ResourceIamMember(IamPubsubLiteReservationSchema, NewPubsubLiteReservationIamUpdater),
+			"google_pubsub_lite_reservation_iam_policy":                       ResourceIamPolicyWithImport(IamPubsubLiteReservationSchema, NewPubsubLiteReservationIamUpdater, PubsubLiteReservationIdParseFunc),
+			"google_pubsub_lite_topic_iam_binding":                            ResourceIamBindingWithImport(IamPubsubLiteTopicSchema, NewPubsubLiteTopicIamUpdater, PubsubLiteTopicIdParseFunc),
+			"google_pubsub_lite_topic_iam_member":                             ResourceIamMember(IamPubsubLiteTopicSchema, NewPubsubLiteTopicIamUpdater),
+			"google_pubsub_lite_topic_iam_policy":                             ResourceIamPolicyWithImport(IamPubsubLiteTopicSchema, NewPubsubLiteTopicIamUpdater, PubsubLiteTopicIdParseFunc),
+			"google_redis_instance_iam_binding":                               ResourceIamBindingWithImport(IamRedisInstanceSchema, NewRedisInstanceIamUpdater, RedisInstanceIdParseFunc),
+			"google_redis_instance_iam_member":                                ResourceIamMember(IamRedisInstanceSchema, NewRedisInstanceIamUpdater),
+			"google_redis_instance_iam_policy":                                ResourceIamPolicyWithImport(IamRedisInstanceSchema, NewRedisInstanceIamUpdater, RedisInstanceIdParseFunc),
+			"google_scc_v2_organization_source_iam_binding":                   ResourceIamBindingWithImport(IamSecurityCenterV2FindingSourceSchema, NewSecurityCenterV2FindingSourceIamUpdater, SecurityCenterV2FindingSourceIdParseFunc),
+			"google_scc_v2_organization_source_iam_member":                    ResourceIamMember(IamSecurityCenterV2FindingSourceSchema, NewSecurityCenterV2FindingSourceIamUpdater),
+			"google_scc_v2_organization_source_iam_policy":                    ResourceIamPolicyWithImport(IamSecurityCenterV2FindingSourceSchema, NewSecurityCenterV2FindingSourceIamUpdater, SecurityCenterV2FindingSourceIdParseFunc),
+			"google_service_account_iam_binding":                              ResourceIamBindingWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
+			"google_service_account_iam_member":                               ResourceIamMember(IamServiceAccountSchema, NewServiceAccountIamUpdater),
+			"google_service_account_iam_policy":                               ResourceIamPolicyWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
+			"google_service_account_workload_identity_binding":                resourceGoogleServiceAccountWorkloadIdentityBinding(),
+			"google_service_directory_namespace_iam_binding":                  ResourceIamBindingWithImport(IamServiceDirectoryNamespaceSchema, NewServiceDirectoryNamespaceIamUpdater, ServiceDirectoryNamespaceIdParseFunc),
+			"google_service_directory_namespace_iam_member":                   ResourceIamMember(IamServiceDirectoryNamespaceSchema, NewServiceDirectoryNamespaceIamUpdater),
+			"google_service_directory_namespace_iam_policy":                   ResourceIamPolicyWithImport(IamServiceDirectoryNamespaceSchema, NewServiceDirectoryNamespaceIamUpdater, ServiceDirectoryNamespaceIdParseFunc),
+			"google_sourcerepo_repository":                                    resourceSourceRepoRepository(),
+			"google_spanner_instance":                                         resourceSpannerInstance(),
+			"google_spanner_database":                                         resourceSpannerDatabase(),
+			"google_sql_database":                                             resourceSqlDatabase(),
+			"google_sql_database_instance":                                    resourceSqlDatabaseInstance(),
+			"google_sql_user":                                                 resourceSqlUser(),
+			"google_organization_iam_binding":                                 ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrganizationIdParseFunc),
+			"google_organization_iam_custom_role":                             resourceGoogleOrganizationIamCustomRole(),
+			"google_organization_iam_member":                                  ResourceIamMember(IamOrganizationSchema, NewOrganizationIamUpdater),
+			"google_organization_policy":                                      resourceGoogleOrganizationPolicy(),
+			"google_project":                                                  resourceGoogleProject(),
+			"google_project_iam_policy":                                       resourceGoogleProjectIamPolicy(),
+			"google_project_iam_binding":                                      ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
+			"google_project_iam_member":                                       ResourceIamMember(IamProjectSchema, NewProjectIamUpdater),
+			"google_project_service":                                          resourceGoogleProjectService(),
+			"google_project_iam_custom_role":                                  resourceGoogleProjectIamCustomRole(),
+			"google_project_services":                                         resourceGoogleProjectServices(),
+			"google_pubsub_topic":                                             resourcePubsubTopic(),
+			"google_pubsub_subscription":                                      resourcePubsubSubscription(),
+			"google_runtimeconfig_config":                                     resourceRuntimeconfigConfig(),
+			"google_runtimeconfig_variable":                                   resourceRuntimeconfigVariable(),
+			"google_service_account":                                          resourceGoogleServiceAccount(),
+			"google_service_account_key":                                      resourceGoogleServiceAccountKey(),
+			"google_storage_bucket":                                           resourceStorageBucket(),
+			"google_storage_bucket_acl":                                       resourceStorageBucketAcl(),
+			"google_storage_bucket_object":                                    resourceStorageBucketObject(),
+			"google_storage_object_acl":                                       resourceStorageObjectAcl(),
+			"google_vertex_ai_index_endpoint_iam_binding":                     ResourceIamBindingWithImport(IamVertexAIIndexEndpointSchema, NewVertexAIIndexEndpointIamUpdater, VertexAIIndexEndpointIdParseFunc),
+			"google_vertex_ai_index_endpoint_iam_member":                      ResourceIamMember(IamVertexAIIndexEndpointSchema, NewVertexAIIndexEndpointIamUpdater),
+			"google_vertex_ai_index_endpoint_iam_policy":                      ResourceIamPolicyWithImport(IamVertexAIIndexEndpointSchema, NewVertexAIIndexEndpointIamUpdater, VertexAIIndexEndpointIdParseFunc),
+			"google_vertex_ai_metadata_store_iam_binding":                     ResourceIamBindingWithImport(IamVertexAIMetadataStoreSchema, NewVertexAIMetadataStoreIamUpdater, VertexAIMetadataStoreIdParseFunc),
+			"google_vertex_ai_metadata_store_iam_member":                      ResourceIamMember(IamVertexAIMetadataStoreSchema, NewVertexAIMetadataStoreIamUpdater),
+			"google_vertex_ai_metadata_store_iam_policy":                      ResourceIamPolicyWithImport(IamVertexAIMetadataStoreSchema, NewVertexAIMetadataStoreIamUpdater, VertexAIMetadataStoreIdParseFunc),
+			"google_vertex_ai_tensorboard_iam_binding":                        ResourceIamBindingWithImport(IamVertexAITensorboardSchema, NewVertexAITensorboardIamUpdater, VertexAITensorboardIdParseFunc),
+			"google_vertex_ai_tensorboard_iam_member":                         ResourceIamMember(IamVertexAITensorboardSchema, NewVertexAITensorboardIamUpdater),
+			"google_vertex_ai_tensorboard_iam_policy":                         ResourceIamPolicyWithImport(IamVertexAITensorboardSchema, NewVertexAITensorboardIamUpdater, VertexAITensorboardIdParseFunc),
+			"google_vmwareengine_private_cloud_iam_binding":                   ResourceIamBindingWithImport(IamVmwareenginePrivateCloudSchema, NewVmwareenginePrivateCloudIamUpdater, VmwareenginePrivateCloudIdParseFunc),
+			"google_vmwareengine_private_cloud_iam_member":                    ResourceIamMember(IamVmwareenginePrivateCloudSchema, NewVmwareenginePrivateCloudIamUpdater),
+			"google_vmwareengine_private_cloud_iam_policy":                    ResourceIamPolicyWithImport(IamVmwareenginePrivateCloudSchema, NewVmwareenginePrivateCloudIamUpdater, VmwareenginePrivateCloudIdParseFunc),
+			"google_workstations_workstation_config_iam_binding":              ResourceIamBindingWithImport(IamWorkstationsWorkstationConfigSchema, NewWorkstationsWorkstationConfigIamUpdater, WorkstationsWorkstationConfigIdParseFunc),
+			"google_workstations_workstation_config_iam_member":               ResourceIamMember(IamWorkstationsWorkstationConfigSchema, NewWorkstationsWorkstationConfigIamUpdater),
+			"google_workstations_workstation_config_iam_policy":               ResourceIamPolicyWithImport(IamWorkstationsWorkstationConfigSchema, NewWorkstationsWorkstationConfigIamUpdater, WorkstationsWorkstationConfigIdParseFunc),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	// The base path of each API called by the IAM resources can be overridden.
+	for api := range defaultBasePaths {
+		provider.Schema[api+"_custom_endpoint"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("GOOGLE_"+strings.ToUpper(api)+"_CUSTOM_ENDPOINT", ""),
+		}
+	}
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -162,12 +363,49 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Credentials: credentials,
 		Project:     d.Get("project").(string),
 		Region:      d.Get("region").(string),
+
+		UserAgentSuffix: d.Get("user_agent_suffix").(string),
+		RedactIamLogs:   d.Get("redact_iam_logs").(bool),
+
+		IamBindingAuthoritativeOnCreate: d.Get("iam_binding_authoritative_on_create").(bool),
+		ReportRedundantIamGrants:        d.Get("report_redundant_iam_grants").(bool),
+		SkipIamParentCheck:              d.Get("skip_iam_parent_check").(bool),
+		DetectDuplicateIamBindings:      d.Get("detect_duplicate_iam_bindings").(bool),
+
+		BasePaths: make(map[string]string),
+	}
+
+	for api := range defaultBasePaths {
+		if v := d.Get(api + "_custom_endpoint").(string); v != "" {
+			config.BasePaths[api] = v
+		}
+	}
+
+	wait, err := time.ParseDuration(d.Get("iam_read_after_create_wait").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid iam_read_after_create_wait %q: %s", d.Get("iam_read_after_create_wait").(string), err)
+	}
+	config.IamReadAfterCreateWait = wait
+
+	if v := d.Get("iam_max_total_retry_duration").(string); v != "" {
+		max, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid iam_max_total_retry_duration %q: %s", v, err)
+		}
+		config.IamMaxTotalRetryDuration = max
 	}
 
 	if err := config.loadAndValidate(); err != nil {
 		return nil, err
 	}
 
+	if config.ReportRedundantIamGrants {
+		config.iamGrants = newIamGrantRegistry(&config)
+	}
+	if config.DetectDuplicateIamBindings {
+		config.iamBindings = newIamBindingRegistry()
+	}
+
 	return &config, nil
 }
 
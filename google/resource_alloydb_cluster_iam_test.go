@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The AlloyDB cluster must already exist, as it can't be managed by this provider.
+func TestAccAlloyDBClusterIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_ALLOYDB_CLUSTER")
+	cluster := os.Getenv("GOOGLE_ALLOYDB_CLUSTER")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, alloyDBClusterIamResource, IamAlloyDBClusterSchema, config, fmt.Sprintf("projects/%s/locations/%s/clusters/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), cluster))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlloyDBClusterIamBinding_basic(account, getTestRegionFromEnv(), cluster),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/alloydb.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccAlloyDBClusterIamBinding_basic(account, location, cluster string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_alloydb_cluster_iam_binding" "foo" {
+  location = "%s"
+  cluster  = "%s"
+  role     = "roles/alloydb.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, cluster)
+}
@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Apigee organization must already exist, as it can't be managed by this provider. Its ID is the ID of the
+// project it was provisioned in.
+func TestAccApigeeOrganizationIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_APIGEE_ORGANIZATION")
+	orgId := os.Getenv("GOOGLE_APIGEE_ORGANIZATION")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, apigeeOrganizationIamResource, IamApigeeOrganizationSchema, config, fmt.Sprintf("organizations/%s", orgId))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApigeeOrganizationIamBinding_basic(account, orgId),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/apigee.admin", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccApigeeOrganizationIamBinding_basic(account, orgId string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_apigee_organization_iam_binding" "foo" {
+  org_id  = "%s"
+  role    = "roles/apigee.admin"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, orgId)
+}
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The App Hub application must already exist, as it can't be managed by this provider.
+func TestAccAppHubApplicationIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_APPHUB_APPLICATION")
+	application := os.Getenv("GOOGLE_APPHUB_APPLICATION")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, appHubApplicationIamResource, IamAppHubApplicationSchema, config, fmt.Sprintf("projects/%s/locations/%s/applications/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), application))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppHubApplicationIamBinding_basic(account, getTestRegionFromEnv(), application),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/apphub.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccAppHubApplicationIamBinding_basic(account, location, application string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_apphub_application_iam_binding" "foo" {
+  location    = "%s"
+  application = "%s"
+  role        = "roles/apphub.viewer"
+  members     = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, application)
+}
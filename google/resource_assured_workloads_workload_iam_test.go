@@ -0,0 +1,54 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The workload must already exist in the test organization, as it can't be managed by this provider.
+func TestAccAssuredWorkloadsWorkloadIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_ASSURED_WORKLOADS_WORKLOAD")
+	workload := os.Getenv("GOOGLE_ASSURED_WORKLOADS_WORKLOAD")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, assuredWorkloadsWorkloadIamResource, IamAssuredWorkloadsWorkloadSchema, config, fmt.Sprintf("organizations/%s/locations/%s/workloads/%s", getTestOrgFromEnv(t), getTestRegionFromEnv(), workload))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssuredWorkloadsWorkloadIamBinding_basic(account, getTestOrgFromEnv(t), getTestRegionFromEnv(), workload),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/assuredworkloads.reader", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccAssuredWorkloadsWorkloadIamBinding_basic(account, organization, location, workload string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_assured_workloads_workload_iam_binding" "foo" {
+  organization = "%s"
+  location     = "%s"
+  workload     = "%s"
+  role         = "roles/assuredworkloads.reader"
+  members      = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, organization, location, workload)
+}
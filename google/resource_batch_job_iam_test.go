@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Batch job must already exist, as it can't be managed by this provider.
+func TestAccBatchJobIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_BATCH_JOB")
+	job := os.Getenv("GOOGLE_BATCH_JOB")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, batchJobIamResource, IamBatchJobSchema, config, fmt.Sprintf("projects/%s/locations/%s/jobs/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), job))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobIamBinding_basic(account, getTestRegionFromEnv(), job),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/batch.jobsViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccBatchJobIamBinding_basic(account, location, job string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_batch_job_iam_binding" "foo" {
+  location = "%s"
+  job      = "%s"
+  role     = "roles/batch.jobsViewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, job)
+}
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The BeyondCorp app connector must already exist, as it can't be managed by this provider.
+func TestAccBeyondcorpAppConnectorIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_BEYONDCORP_APP_CONNECTOR")
+	connector := os.Getenv("GOOGLE_BEYONDCORP_APP_CONNECTOR")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, beyondcorpAppConnectorIamResource, IamBeyondcorpAppConnectorSchema, config, fmt.Sprintf("projects/%s/locations/%s/appConnectors/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), connector))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBeyondcorpAppConnectorIamBinding_basic(account, getTestRegionFromEnv(), connector),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/beyondcorp.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccBeyondcorpAppConnectorIamBinding_basic(account, region, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_beyondcorp_app_connector_iam_binding" "foo" {
+  region  = "%s"
+  name    = "%s"
+  role    = "roles/beyondcorp.viewer"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, name)
+}
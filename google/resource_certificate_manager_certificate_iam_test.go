@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Certificate Manager certificate must already exist, as it can't be managed by this provider.
+func TestAccCertificateManagerCertificateIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_CERTIFICATE_MANAGER_CERTIFICATE")
+	certificate := os.Getenv("GOOGLE_CERTIFICATE_MANAGER_CERTIFICATE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, certificateManagerCertificateIamResource, IamCertificateManagerCertificateSchema, config, fmt.Sprintf("projects/%s/locations/%s/certificates/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), certificate))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateManagerCertificateIamBinding_basic(account, getTestRegionFromEnv(), certificate),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/certificatemanager.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccCertificateManagerCertificateIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_certificate_manager_certificate_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/certificatemanager.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Cloud Build worker pool must already exist, as it can't be managed by this provider.
+func TestAccCloudBuildWorkerPoolIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_CLOUDBUILD_WORKER_POOL")
+	pool := os.Getenv("GOOGLE_CLOUDBUILD_WORKER_POOL")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, cloudBuildWorkerPoolIamResource, IamCloudBuildWorkerPoolSchema, config, fmt.Sprintf("projects/%s/locations/%s/workerPools/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), pool))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudBuildWorkerPoolIamBinding_basic(account, getTestRegionFromEnv(), pool),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/cloudbuild.workerPoolUser", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccCloudBuildWorkerPoolIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_cloudbuild_worker_pool_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/cloudbuild.workerPoolUser"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
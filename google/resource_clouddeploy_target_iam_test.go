@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Cloud Deploy target must already exist, as it can't be managed by this provider.
+func TestAccCloudDeployTargetIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_CLOUDDEPLOY_TARGET")
+	target := os.Getenv("GOOGLE_CLOUDDEPLOY_TARGET")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, cloudDeployTargetIamResource, IamCloudDeployTargetSchema, config, fmt.Sprintf("projects/%s/locations/%s/targets/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), target))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudDeployTargetIamBinding_basic(account, getTestRegionFromEnv(), target),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/clouddeploy.releaser", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccCloudDeployTargetIamBinding_basic(account, location, target string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_clouddeploy_target_iam_binding" "foo" {
+  location = "%s"
+  target   = "%s"
+  role     = "roles/clouddeploy.releaser"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, target)
+}
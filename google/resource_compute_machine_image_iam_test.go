@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The machine image must already exist, as it can't be managed by this provider.
+func TestAccComputeMachineImageIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_COMPUTE_MACHINE_IMAGE")
+	machineImage := os.Getenv("GOOGLE_COMPUTE_MACHINE_IMAGE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, computeMachineImageIamResource, IamComputeMachineImageSchema, config, fmt.Sprintf("projects/%s/global/machineImages/%s", getTestProjectFromEnv(), machineImage))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeMachineImageIamBinding_basic(account, machineImage),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/compute.imageUser", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccComputeMachineImageIamBinding_basic(account, machine_image string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_compute_machine_image_iam_binding" "foo" {
+  machine_image = "%s"
+  role          = "roles/compute.imageUser"
+  members       = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, machine_image)
+}
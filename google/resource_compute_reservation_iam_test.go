@@ -0,0 +1,55 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The compute reservation must already exist, as it can't be managed by this provider. Reservations are zonal,
+// so their zone is given along with their name.
+func TestAccComputeReservationIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_COMPUTE_RESERVATION_ZONE", "GOOGLE_COMPUTE_RESERVATION")
+	zone := os.Getenv("GOOGLE_COMPUTE_RESERVATION_ZONE")
+	reservation := os.Getenv("GOOGLE_COMPUTE_RESERVATION")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, computeReservationIamResource, IamComputeReservationSchema, config, fmt.Sprintf("projects/%s/zones/%s/reservations/%s", getTestProjectFromEnv(), zone, reservation))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeReservationIamBinding_basic(account, zone, reservation),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/compute.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccComputeReservationIamBinding_basic(account, zone, reservation string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_compute_reservation_iam_binding" "foo" {
+  zone        = "%s"
+  reservation = "%s"
+  role        = "roles/compute.viewer"
+  members     = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, zone, reservation)
+}
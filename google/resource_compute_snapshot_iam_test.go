@@ -0,0 +1,110 @@
+package google
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestComputeSnapshotIamUpdater(t *testing.T) {
+	var requests []string
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.String())
+		return testResponse(200, `{"etag":"BwVZ1Q==","bindings":[{"role":"roles/compute.storageAdmin","members":["user:admin@example.com"]}]}`), nil
+	})
+
+	d := schema.TestResourceDataRaw(t, IamComputeSnapshotSchema, map[string]interface{}{
+		"snapshot": "my-snapshot",
+	})
+	updater, err := NewComputeSnapshotIamUpdater(d, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expectedId := "projects/my-project/global/snapshots/my-snapshot"
+	if updater.GetResourceId() != expectedId {
+		t.Fatalf("Expected resource id %q, got %q", expectedId, updater.GetResourceId())
+	}
+
+	p, err := updater.GetResourceIamPolicy()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(p.Bindings) != 1 || p.Bindings[0].Role != "roles/compute.storageAdmin" {
+		t.Fatalf("Unexpected policy %+v", p)
+	}
+
+	p, err = updater.SetResourceIamPolicy(&cloudresourcemanager.Policy{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if p.Etag != "BwVZ1Q==" {
+		t.Fatalf("Expected the applied policy to be returned, got %+v", p)
+	}
+
+	// The compute API exposes the IAM methods as sub-resources.
+	expected := []string{
+		"GET https://www.googleapis.com/compute/v1/" + expectedId + "/getIamPolicy",
+		"POST https://www.googleapis.com/compute/v1/" + expectedId + "/setIamPolicy",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestAccComputeSnapshotIamBinding(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	snapshotName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, computeSnapshotIamResource, IamComputeSnapshotSchema, config, fmt.Sprintf("projects/%s/global/snapshots/%s", getTestProjectFromEnv(), snapshotName))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSnapshotIamBinding_basic(account, diskName, snapshotName),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/compute.storageAdmin", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccComputeSnapshotIamBinding_basic(account, diskName, snapshotName string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_compute_disk" "foobar" {
+  name  = "%s"
+  image = "debian-8-jessie-v20160921"
+  size  = 10
+  type  = "pd-ssd"
+  zone  = "us-central1-a"
+}
+
+resource "google_compute_snapshot" "foobar" {
+  name        = "%s"
+  source_disk = "${google_compute_disk.foobar.name}"
+  zone        = "us-central1-a"
+}
+
+resource "google_compute_snapshot_iam_binding" "foo" {
+  snapshot = "${google_compute_snapshot.foobar.name}"
+  role     = "roles/compute.storageAdmin"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, diskName, snapshotName)
+}
@@ -0,0 +1,97 @@
+package google
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestContainerClusterIamUpdater(t *testing.T) {
+	var requests []string
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.String())
+		return testResponse(200, `{"etag":"BwVZ1Q==","bindings":[{"role":"roles/gkehub.gatewayReader","members":["user:admin@example.com"]}]}`), nil
+	})
+
+	d := schema.TestResourceDataRaw(t, IamContainerClusterSchema, map[string]interface{}{
+		"cluster": "my-cluster",
+	})
+	updater, err := NewContainerClusterIamUpdater(d, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expectedId := "projects/my-project/locations/global/memberships/my-cluster"
+	if updater.GetResourceId() != expectedId {
+		t.Fatalf("Expected resource id %q, got %q", expectedId, updater.GetResourceId())
+	}
+
+	p, err := updater.GetResourceIamPolicy()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(p.Bindings) != 1 || p.Bindings[0].Role != "roles/gkehub.gatewayReader" {
+		t.Fatalf("Unexpected policy %+v", p)
+	}
+
+	p, err = updater.SetResourceIamPolicy(&cloudresourcemanager.Policy{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if p.Etag != "BwVZ1Q==" {
+		t.Fatalf("Expected the applied policy to be returned, got %+v", p)
+	}
+
+	expected := []string{
+		"GET https://gkehub.googleapis.com/v1/" + expectedId + ":getIamPolicy",
+		"POST https://gkehub.googleapis.com/v1/" + expectedId + ":setIamPolicy",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+// The cluster must already be registered to the GKE Hub of the test project, as
+// registration can't be managed by this provider.
+func TestAccContainerClusterIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_GKE_HUB_CLUSTER")
+	cluster := os.Getenv("GOOGLE_GKE_HUB_CLUSTER")
+	account := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerClusterIamBinding_basic(account, cluster),
+				Check: testAccCheckIamBindingMembers(func(config *Config) ResourceIamUpdater {
+					return testGenericIamUpdater(t, containerClusterIamResource, IamContainerClusterSchema, config, fmt.Sprintf("projects/%s/locations/global/memberships/%s", getTestProjectFromEnv(), cluster))
+				}, "roles/gkehub.gatewayReader", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccContainerClusterIamBinding_basic(account, cluster string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_container_cluster_iam_binding" "foo" {
+  cluster = "%s"
+  role    = "roles/gkehub.gatewayReader"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, cluster)
+}
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Data Fusion instance must already exist, as it can't be managed by this provider.
+func TestAccDataFusionInstanceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_DATA_FUSION_INSTANCE")
+	instance := os.Getenv("GOOGLE_DATA_FUSION_INSTANCE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, dataFusionInstanceIamResource, IamDataFusionInstanceSchema, config, fmt.Sprintf("projects/%s/locations/%s/instances/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), instance))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataFusionInstanceIamBinding_basic(account, getTestRegionFromEnv(), instance),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/datafusion.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccDataFusionInstanceIamBinding_basic(account, region, instance string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_data_fusion_instance_iam_binding" "foo" {
+  region   = "%s"
+  instance = "%s"
+  role     = "roles/datafusion.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, instance)
+}
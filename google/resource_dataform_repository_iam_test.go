@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Dataform repository must already exist, as it can't be managed by this provider.
+func TestAccDataformRepositoryIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_DATAFORM_REPOSITORY")
+	repository := os.Getenv("GOOGLE_DATAFORM_REPOSITORY")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, dataformRepositoryIamResource, IamDataformRepositorySchema, config, fmt.Sprintf("projects/%s/locations/%s/repositories/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), repository))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataformRepositoryIamBinding_basic(account, getTestRegionFromEnv(), repository),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/dataform.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccDataformRepositoryIamBinding_basic(account, region, repository string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_dataform_repository_iam_binding" "foo" {
+  region     = "%s"
+  repository = "%s"
+  role       = "roles/dataform.viewer"
+  members    = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, repository)
+}
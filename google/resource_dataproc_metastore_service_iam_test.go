@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Dataproc Metastore service must already exist, as it can't be managed by this provider.
+func TestAccDataprocMetastoreServiceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_DATAPROC_METASTORE_SERVICE")
+	service := os.Getenv("GOOGLE_DATAPROC_METASTORE_SERVICE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, dataprocMetastoreServiceIamResource, IamDataprocMetastoreServiceSchema, config, fmt.Sprintf("projects/%s/locations/%s/services/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), service))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocMetastoreServiceIamBinding_basic(account, getTestRegionFromEnv(), service),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/metastore.user", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccDataprocMetastoreServiceIamBinding_basic(account, location, service string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_dataproc_metastore_service_iam_binding" "foo" {
+  location = "%s"
+  service  = "%s"
+  role     = "roles/metastore.user"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, service)
+}
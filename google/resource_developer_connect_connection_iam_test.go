@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Developer Connect connection must already exist, as it can't be managed by this provider.
+func TestAccDeveloperConnectConnectionIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_DEVELOPER_CONNECT_CONNECTION")
+	name := os.Getenv("GOOGLE_DEVELOPER_CONNECT_CONNECTION")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, developerConnectConnectionIamResource, IamDeveloperConnectConnectionSchema, config, fmt.Sprintf("projects/%s/locations/%s/connections/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), name))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeveloperConnectConnectionIamBinding_basic(account, getTestRegionFromEnv(), name),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/developerconnect.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccDeveloperConnectConnectionIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_developer_connect_connection_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/developerconnect.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
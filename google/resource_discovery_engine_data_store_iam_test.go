@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Discovery Engine data store must already exist, as it can't be managed by this provider.
+func TestAccDiscoveryEngineDataStoreIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_DISCOVERY_ENGINE_DATA_STORE")
+	dataStore := os.Getenv("GOOGLE_DISCOVERY_ENGINE_DATA_STORE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, discoveryEngineDataStoreIamResource, IamDiscoveryEngineDataStoreSchema, config, fmt.Sprintf("projects/%s/locations/global/collections/default_collection/dataStores/%s", getTestProjectFromEnv(), dataStore))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscoveryEngineDataStoreIamBinding_basic(account, dataStore),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/discoveryengine.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccDiscoveryEngineDataStoreIamBinding_basic(account, data_store string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_discovery_engine_data_store_iam_binding" "foo" {
+  data_store = "%s"
+  role       = "roles/discoveryengine.viewer"
+  members    = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, data_store)
+}
@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Discovery Engine engine must already exist, as it can't be managed by this provider.
+func TestAccDiscoveryEngineEngineIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_DISCOVERY_ENGINE_ENGINE")
+	engine := os.Getenv("GOOGLE_DISCOVERY_ENGINE_ENGINE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, discoveryEngineEngineIamResource, IamDiscoveryEngineEngineSchema, config, fmt.Sprintf("projects/%s/locations/global/collections/default_collection/engines/%s", getTestProjectFromEnv(), engine))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscoveryEngineEngineIamBinding_basic(account, engine),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/discoveryengine.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccDiscoveryEngineEngineIamBinding_basic(account, engine string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_discovery_engine_engine_iam_binding" "foo" {
+  engine  = "%s"
+  role    = "roles/discoveryengine.viewer"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, engine)
+}
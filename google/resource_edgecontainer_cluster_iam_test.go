@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Distributed Cloud Edge cluster must already exist, as it can't be managed by this provider.
+func TestAccEdgeContainerClusterIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_EDGECONTAINER_CLUSTER")
+	cluster := os.Getenv("GOOGLE_EDGECONTAINER_CLUSTER")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, edgeContainerClusterIamResource, IamEdgeContainerClusterSchema, config, fmt.Sprintf("projects/%s/locations/%s/clusters/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), cluster))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEdgeContainerClusterIamBinding_basic(account, getTestRegionFromEnv(), cluster),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/edgecontainer.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccEdgeContainerClusterIamBinding_basic(account, location, cluster string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_edgecontainer_cluster_iam_binding" "foo" {
+  location = "%s"
+  cluster  = "%s"
+  role     = "roles/edgecontainer.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, cluster)
+}
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Eventarc channel must already exist, as it can't be managed by this provider.
+func TestAccEventarcChannelIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_EVENTARC_CHANNEL")
+	channel := os.Getenv("GOOGLE_EVENTARC_CHANNEL")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, eventarcChannelIamResource, IamEventarcChannelSchema, config, fmt.Sprintf("projects/%s/locations/%s/channels/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), channel))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventarcChannelIamBinding_basic(account, getTestRegionFromEnv(), channel),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/eventarc.publisher", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccEventarcChannelIamBinding_basic(account, location, channel string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_eventarc_channel_iam_binding" "foo" {
+  location = "%s"
+  channel  = "%s"
+  role     = "roles/eventarc.publisher"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, channel)
+}
@@ -0,0 +1,52 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Firestore database must already exist, as it can't be managed by this provider.
+func TestAccFirestoreDatabaseIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_FIRESTORE_DATABASE")
+	database := os.Getenv("GOOGLE_FIRESTORE_DATABASE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, firestoreDatabaseIamResource, IamFirestoreDatabaseSchema, config, fmt.Sprintf("projects/%s/databases/%s", getTestProjectFromEnv(), database))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreDatabaseIamBinding_basic(account, database),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/datastore.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccFirestoreDatabaseIamBinding_basic(account, database string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_firestore_database_iam_binding" "foo" {
+  database = "%s"
+  role     = "roles/datastore.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, database)
+}
@@ -0,0 +1,52 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Gemini for Google Cloud enablement setting must already exist, as it can't be managed by this provider.
+func TestAccGeminiGcpEnablementSettingIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_GEMINI_GCP_ENABLEMENT_SETTING")
+	setting := os.Getenv("GOOGLE_GEMINI_GCP_ENABLEMENT_SETTING")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, geminiGcpEnablementSettingIamResource, IamGeminiGcpEnablementSettingSchema, config, fmt.Sprintf("projects/%s/locations/global/geminiGcpEnablementSettings/%s", getTestProjectFromEnv(), setting))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeminiGcpEnablementSettingIamBinding_basic(account, setting),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/cloudaicompanion.settingsUser", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccGeminiGcpEnablementSettingIamBinding_basic(account, setting string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_gemini_gemini_gcp_enablement_setting_iam_binding" "foo" {
+  setting = "%s"
+  role    = "roles/cloudaicompanion.settingsUser"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, setting)
+}
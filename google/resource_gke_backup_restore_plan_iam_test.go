@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The GKE Backup restore plan must already exist, as it can't be managed by this provider.
+func TestAccGkeBackupRestorePlanIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_GKE_BACKUP_RESTORE_PLAN")
+	name := os.Getenv("GOOGLE_GKE_BACKUP_RESTORE_PLAN")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, gkeBackupRestorePlanIamResource, IamGkeBackupRestorePlanSchema, config, fmt.Sprintf("projects/%s/locations/%s/restorePlans/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), name))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGkeBackupRestorePlanIamBinding_basic(account, getTestRegionFromEnv(), name),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/gkebackup.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccGkeBackupRestorePlanIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_gke_backup_restore_plan_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/gkebackup.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
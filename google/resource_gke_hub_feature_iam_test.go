@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The GKE Hub feature must already exist, as it can't be managed by this provider.
+func TestAccGkeHubFeatureIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_GKE_HUB_FEATURE")
+	feature := os.Getenv("GOOGLE_GKE_HUB_FEATURE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, gkeHubFeatureIamResource, IamGkeHubFeatureSchema, config, fmt.Sprintf("projects/%s/locations/global/features/%s", getTestProjectFromEnv(), feature))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGkeHubFeatureIamBinding_basic(account, feature),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/gkehub.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccGkeHubFeatureIamBinding_basic(account, feature string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_gke_hub_feature_iam_binding" "foo" {
+  feature = "%s"
+  role    = "roles/gkehub.viewer"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, feature)
+}
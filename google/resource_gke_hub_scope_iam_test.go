@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The GKE Hub scope must already exist, as it can't be managed by this provider.
+func TestAccGkeHubScopeIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_GKE_HUB_SCOPE")
+	scope := os.Getenv("GOOGLE_GKE_HUB_SCOPE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, gkeHubScopeIamResource, IamGkeHubScopeSchema, config, fmt.Sprintf("projects/%s/locations/global/scopes/%s", getTestProjectFromEnv(), scope))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGkeHubScopeIamBinding_basic(account, scope),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/gkehub.scopeViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccGkeHubScopeIamBinding_basic(account, scope string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_gke_hub_scope_iam_binding" "foo" {
+  scope   = "%s"
+  role    = "roles/gkehub.scopeViewer"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, scope)
+}
@@ -0,0 +1,91 @@
+package google
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestGkeMultiCloudAttachedClusterIamUpdater(t *testing.T) {
+	var requests []string
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.String())
+		return testResponse(200, `{"etag":"BwVZ1Q==","bindings":[{"role":"roles/gkemulticloud.viewer","members":["user:admin@example.com"]}]}`), nil
+	})
+
+	d := schema.TestResourceDataRaw(t, IamGkeMultiCloudAttachedClusterSchema, map[string]interface{}{
+		"location": "us-west1",
+		"name":     "my-cluster",
+	})
+	updater, err := NewGkeMultiCloudAttachedClusterIamUpdater(d, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expectedId := "projects/my-project/locations/us-west1/attachedClusters/my-cluster"
+	if updater.GetResourceId() != expectedId {
+		t.Fatalf("Expected resource id %q, got %q", expectedId, updater.GetResourceId())
+	}
+
+	if _, err := updater.GetResourceIamPolicy(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := updater.SetResourceIamPolicy(&cloudresourcemanager.Policy{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The requests are sent to the endpoint of the location of the cluster.
+	expected := []string{
+		"GET https://us-west1-gkemulticloud.googleapis.com/v1/" + expectedId + ":getIamPolicy",
+		"POST https://us-west1-gkemulticloud.googleapis.com/v1/" + expectedId + ":setIamPolicy",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+// The attached cluster must already exist, as it can't be managed by this provider.
+func TestAccGkeMultiCloudAttachedClusterIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_GKE_MULTI_CLOUD_ATTACHED_CLUSTER")
+	cluster := os.Getenv("GOOGLE_GKE_MULTI_CLOUD_ATTACHED_CLUSTER")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, gkeMultiCloudAttachedClusterIamResource, IamGkeMultiCloudAttachedClusterSchema, config, fmt.Sprintf("projects/%s/locations/%s/attachedClusters/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), cluster))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGkeMultiCloudAttachedClusterIamBinding_basic(account, getTestRegionFromEnv(), cluster),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/gkemulticloud.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccGkeMultiCloudAttachedClusterIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_gke_multi_cloud_attached_cluster_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/gkemulticloud.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
@@ -38,6 +38,11 @@ func TestAccGoogleFolderIamPolicy_basic(t *testing.T) {
 				Config: testAccGoogleFolderIamPolicy_basic(folderDisplayName, parent, policy),
 				Check:  testAccCheckGoogleFolderIamPolicy("google_folder_iam_policy.test", policy),
 			},
+			resource.TestStep{
+				ResourceName:      "google_folder_iam_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
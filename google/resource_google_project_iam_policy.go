@@ -57,6 +57,11 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
+
+	mutexKey := projectIamMutexKey(pid)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
 	// Get the policy in the template
 	p, err := getResourceIamPolicy(d)
 	if err != nil {
@@ -148,6 +153,10 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	mutexKey := projectIamMutexKey(pid)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
 	// Get the policy in the template
 	p, err := getResourceIamPolicy(d)
 	if err != nil {
@@ -215,6 +224,10 @@ func resourceGoogleProjectIamPolicyDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	mutexKey := projectIamMutexKey(pid)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
 	// Get the existing IAM policy from the API
 	ep, err := getProjectIamPolicy(pid, config)
 	if err != nil {
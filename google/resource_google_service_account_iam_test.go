@@ -0,0 +1,67 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestServiceAccountName(t *testing.T) {
+	cases := map[string]string{
+		"my-sa@my-project.iam.gserviceaccount.com":                                     "projects/-/serviceAccounts/my-sa@my-project.iam.gserviceaccount.com",
+		"projects/my-project/serviceAccounts/my-sa@my-project.iam.gserviceaccount.com": "projects/my-project/serviceAccounts/my-sa@my-project.iam.gserviceaccount.com",
+	}
+
+	for id, expected := range cases {
+		if name := serviceAccountName(id); name != expected {
+			t.Errorf("%s: expected name %q, got %q", id, expected, name)
+		}
+	}
+}
+
+func TestAccServiceAccountIamBinding(t *testing.T) {
+	t.Parallel()
+
+	account := acctest.RandomWithPrefix("tf-test")
+	target := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return &ServiceAccountIamUpdater{
+			serviceAccountId: fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com", getTestProjectFromEnv(), target, getTestProjectFromEnv()),
+			Config:           config,
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountIamBinding_basic(account, target),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/iam.serviceAccountUser", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccServiceAccountIamBinding_basic(account, target string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_service_account" "target" {
+  account_id   = "%s"
+  display_name = "Iam Testing Target Account"
+}
+
+resource "google_service_account_iam_binding" "foo" {
+  service_account_id = "${google_service_account.target.name}"
+  role               = "roles/iam.serviceAccountUser"
+  members            = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, target)
+}
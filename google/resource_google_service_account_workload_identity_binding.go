@@ -0,0 +1,114 @@
+package google
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"regexp"
+)
+
+// The role allowing a Kubernetes service account to impersonate a service account through workload identity.
+const workloadIdentityUserRole = "roles/iam.workloadIdentityUser"
+
+// The names of Kubernetes namespaces are DNS labels, and those of service accounts DNS subdomains.
+const (
+	k8sNamespaceRegex      = "^[a-z0-9](?:[-a-z0-9]{0,61}[a-z0-9])?$"
+	k8sServiceAccountRegex = "^[a-z0-9](?:[-a-z0-9]*[a-z0-9])?(?:\\.[a-z0-9](?:[-a-z0-9]*[a-z0-9])?)*$"
+
+	workloadIdentityMemberRegex = "^serviceAccount:(?:" + ProjectRegex + ")\\.svc\\.id\\.goog\\[[a-z0-9](?:[-a-z0-9]{0,61}[a-z0-9])?/[a-z0-9](?:[-.a-z0-9]*[a-z0-9])?\\]$"
+)
+
+// resourceGoogleServiceAccountWorkloadIdentityBinding grants the workload identity user role on a service
+// account to a Kubernetes service account, as a google_service_account_iam_member would with the member
+// built from the namespace and name of the Kubernetes service account.
+func resourceGoogleServiceAccountWorkloadIdentityBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGoogleServiceAccountWorkloadIdentityBindingCreate,
+		Read:   resourceIamMemberRead(NewServiceAccountIamUpdater),
+		Delete: resourceIamMemberDelete(NewServiceAccountIamUpdater),
+
+		Schema: map[string]*schema.Schema{
+			"service_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(k8sNamespaceRegex),
+			},
+			"k8s_service_account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(k8sServiceAccountRegex),
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Read by the IAM member functions, but not configurable.
+			"expand_custom_role": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"normalize_role_case": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ignore_deleted_members": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_applied_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_applied_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGoogleServiceAccountWorkloadIdentityBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	member, err := workloadIdentityMember(project, d.Get("namespace").(string), d.Get("k8s_service_account").(string))
+	if err != nil {
+		return err
+	}
+	d.Set("role", workloadIdentityUserRole)
+	d.Set("member", member)
+
+	return resourceIamMemberCreate(NewServiceAccountIamUpdater)(d, meta)
+}
+
+// workloadIdentityMember returns the member identifying the Kubernetes service account ksa of namespace in
+// the workload identity pool of project, e.g. `serviceAccount:my-project.svc.id.goog[default/my-ksa]`.
+func workloadIdentityMember(project, namespace, ksa string) (string, error) {
+	member := fmt.Sprintf("serviceAccount:%s.svc.id.goog[%s/%s]", project, namespace, ksa)
+	if !regexp.MustCompile(workloadIdentityMemberRegex).MatchString(member) {
+		return "", fmt.Errorf("%q isn't a valid workload identity member, expected serviceAccount:{project}.svc.id.goog[{namespace}/{k8s_service_account}]", member)
+	}
+	return member, nil
+}
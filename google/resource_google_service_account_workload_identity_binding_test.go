@@ -0,0 +1,81 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestWorkloadIdentityMember(t *testing.T) {
+	cases := map[string]struct {
+		project   string
+		namespace string
+		ksa       string
+		expected  string
+		err       bool
+	}{
+		"basic": {
+			project:   "my-project",
+			namespace: "default",
+			ksa:       "my-ksa",
+			expected:  "serviceAccount:my-project.svc.id.goog[default/my-ksa]",
+		},
+		"dotted service account": {
+			project:   "my-project",
+			namespace: "kube-system",
+			ksa:       "metrics.agent",
+			expected:  "serviceAccount:my-project.svc.id.goog[kube-system/metrics.agent]",
+		},
+		"upper case namespace": {
+			project:   "my-project",
+			namespace: "Default",
+			ksa:       "my-ksa",
+			err:       true,
+		},
+		"empty service account": {
+			project:   "my-project",
+			namespace: "default",
+			ksa:       "",
+			err:       true,
+		},
+	}
+
+	for tn, tc := range cases {
+		member, err := workloadIdentityMember(tc.project, tc.namespace, tc.ksa)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error, got member %q", tn, member)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if member != tc.expected {
+			t.Errorf("%s: expected member %q, got %q", tn, tc.expected, member)
+		}
+	}
+}
+
+func TestValidateK8sServiceAccount(t *testing.T) {
+	cases := map[string]bool{
+		"my-ksa":         true,
+		"metrics.agent":  true,
+		"a":              true,
+		"My-KSA":         false,
+		"-my-ksa":        false,
+		"my-ksa-":        false,
+		"my_ksa":         false,
+		"my..ksa":        false,
+		"default/my-ksa": false,
+	}
+
+	for ksa, valid := range cases {
+		_, errs := validateRegexp(k8sServiceAccountRegex)(ksa, "k8s_service_account")
+		if valid && len(errs) > 0 {
+			t.Errorf("%q: expected to be valid, got %v", ksa, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("%q: expected to be invalid", ksa)
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package google
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"io/ioutil"
 	"log"
+	"sort"
+	"strings"
 )
 
 var iamBindingSchema = map[string]*schema.Schema{
@@ -14,15 +18,81 @@ var iamBindingSchema = map[string]*schema.Schema{
 	},
 	"members": {
 		Type:     schema.TypeSet,
-		Required: true,
+		Optional: true,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validateIamMember,
 		},
 	},
+	"members_file": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"system_managed_members": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateIamMemberPattern,
+		},
+		Set: schema.HashString,
+	},
+	"expand_custom_role": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		ForceNew: true,
+		Default:  false,
+	},
+	"normalize_role_case": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"ignore_deleted_members": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"strict_create": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"authoritative_on_create": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+	"check_etag_on_delete": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"allow_empty": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"additive_update": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"export_policy_to": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 	"etag": {
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"last_applied_etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_applied_time": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
 }
 
 func ResourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
@@ -36,6 +106,16 @@ func ResourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdat
 	}
 }
 
+// ResourceIamBindingWithImport returns an IAM binding resource which can be imported by the ID of its parent,
+// in the format understood by resourceIdParser, followed by a space and the role.
+func ResourceIamBindingWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc) *schema.Resource {
+	r := ResourceIamBinding(parentSpecificSchema, newUpdaterFunc)
+	r.Importer = &schema.ResourceImporter{
+		State: resourceIamBindingImport(newUpdaterFunc, resourceIdParser),
+	}
+	return r
+}
+
 func resourceIamBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
@@ -44,8 +124,31 @@ func resourceIamBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.C
 			return err
 		}
 
-		p := getResourceIamBinding(d)
-		err = iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		p, err := getResourceIamBinding(d, updater)
+		if err != nil {
+			return err
+		}
+		if err := checkIamBindingNotEmpty(d, updater, p); err != nil {
+			return err
+		}
+		if err := registerIamBinding(config, updater, p.Role); err != nil {
+			return err
+		}
+		authoritative := isIamBindingAuthoritativeOnCreate(d, config)
+		applied, err := iamPolicyReadModifyWrite(config, updater, func(ep *cloudresourcemanager.Policy) error {
+			if d.Get("strict_create").(bool) {
+				if foreign := getForeignIamBindingMembers(d, config, updater, ep, p); len(foreign) > 0 {
+					return fmt.Errorf("Binding for role %q of %s already has members which aren't in `members` or `members_file`: %s. Add them to `members` or remove them from the policy before creating the binding.",
+						p.Role, updater.DescribeResource(), strings.Join(foreign, ", "))
+				}
+			}
+
+			if authoritative {
+				b := preserveSystemManagedIamMembers(d, ep, p)
+				ep.Bindings = append(removeIamBindingsForRoles(ep.Bindings, map[string]bool{p.Role: true}), b)
+				return nil
+			}
+
 			// Creating a binding does not remove existing members if they are not in the provided members list.
 			// This prevents removing existing permission without the user's knowledge.
 			// Instead, a diff is shown in that case after creation. Subsequent calls to update will remove any
@@ -56,8 +159,13 @@ func resourceIamBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.C
 		if err != nil {
 			return err
 		}
+		setLastAppliedIamPolicy(d, applied)
+		exportIamPolicy(d, updater, applied)
 		d.SetId(updater.GetResourceId() + "/" + p.Role)
-		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+		if applied == nil {
+			return readIamAfterCreate(d, meta, resourceIamBindingRead(newUpdaterFunc))
+		}
+		return setIamBindingStateFromWrite(d, meta, updater, newUpdaterFunc, p.Role, applied)
 	}
 }
 
@@ -69,33 +177,65 @@ func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Rea
 			return err
 		}
 
-		eBinding := getResourceIamBinding(d)
-		p, err := updater.GetResourceIamPolicy()
+		eBinding, err := getResourceIamBinding(d, updater)
 		if err != nil {
 			return err
 		}
-		log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
-
-		var binding *cloudresourcemanager.Binding
-		for _, b := range p.Bindings {
-			if b.Role != eBinding.Role {
-				continue
-			}
-			binding = b
-			break
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return newIamError(err)
 		}
-		if binding == nil {
-			log.Printf("[DEBUG]: Binding for role %q not found in policy for %s, removing from state file.\n", eBinding.Role, updater.DescribeResource())
-			d.SetId("")
-			return nil
+		log.Printf("[DEBUG]: Retrieved policy for %s (%s): %s\n", updater.DescribeResource(), updater.GetResourceDescriptor(), formatIamPolicyForLog(config, p))
+
+		setIamBindingState(d, config, updater, eBinding.Role, p)
+		if d.Id() != "" {
+			recordIamGrants(config, updater, eBinding.Role, convertStringSet(d.Get("members").(*schema.Set)))
 		}
-		d.Set("etag", p.Etag)
-		d.Set("members", binding.Members)
-		d.Set("role", binding.Role)
 		return nil
 	}
 }
 
+// setIamBindingState sets the state of the binding for role from the policy p, or removes the binding
+// from state if p doesn't have it.
+func setIamBindingState(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, role string, p *cloudresourcemanager.Policy) {
+	var binding *cloudresourcemanager.Binding
+	for _, b := range p.Bindings {
+		if b.Role != role {
+			continue
+		}
+		binding = b
+		break
+	}
+	if binding == nil {
+		log.Printf("[DEBUG]: Binding for role %q not found in policy for %s, removing from state file.\n", role, updater.DescribeResource())
+		d.SetId("")
+		return
+	}
+	members := getIamBindingMembersForState(d, config, updater, binding)
+	members = excludeIamMembersFile(d, config, updater, binding.Role, members)
+	members = excludeSystemManagedIamMembers(d, members)
+	if managed := d.Get("members").(*schema.Set); d.Get("additive_update").(bool) && managed.Len() > 0 {
+		// The members granted the role outside of Terraform are preserved by updates, so they aren't a diff.
+		members = keepIamMembers(members, managed)
+	}
+	logIamBindingMembersDrift(d, config, updater, binding.Role, members)
+	d.Set("etag", p.Etag)
+	d.Set("members", members)
+	// The role is left as configured, as it may be the short name of a custom role.
+}
+
+// setIamBindingStateFromWrite sets the state of the binding from the policy returned by the write, and
+// falls back to reading the policy when there is none, i.e. when nothing was written.
+func setIamBindingStateFromWrite(d *schema.ResourceData, meta interface{}, updater ResourceIamUpdater, newUpdaterFunc newResourceIamUpdaterFunc, role string, applied *cloudresourcemanager.Policy) error {
+	if applied == nil {
+		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+	}
+
+	log.Printf("[DEBUG]: Setting the state of the binding for role %q of %s from the applied policy\n", role, updater.DescribeResource())
+	setIamBindingState(d, meta.(*Config), updater, role, applied)
+	return nil
+}
+
 func resourceIamBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
@@ -104,27 +244,53 @@ func resourceIamBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.U
 			return err
 		}
 
-		binding := getResourceIamBinding(d)
-		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		binding, err := getResourceIamBinding(d, updater)
+		if err != nil {
+			return err
+		}
+		if err := checkIamBindingNotEmpty(d, updater, binding); err != nil {
+			return err
+		}
+		if err := registerIamBinding(config, updater, binding.Role); err != nil {
+			return err
+		}
+		applied, err := iamPolicyReadModifyWrite(config, updater, func(p *cloudresourcemanager.Policy) error {
+			if d.Get("additive_update").(bool) {
+				// Only the members removed from `members` are removed from the binding, the members granted the
+				// role outside of Terraform are kept as they are on create.
+				o, _ := d.GetChange("members")
+				removed := o.(*schema.Set).Difference(d.Get("members").(*schema.Set))
+				for _, b := range p.Bindings {
+					if b.Role == binding.Role {
+						b.Members = removeIamMembers(b.Members, removed)
+					}
+				}
+				p.Bindings = mergeBindings(append(p.Bindings, binding))
+				return nil
+			}
+
+			kept := preserveSystemManagedIamMembers(d, p, binding)
 			var found bool
 			for pos, b := range p.Bindings {
 				if b.Role != binding.Role {
 					continue
 				}
 				found = true
-				p.Bindings[pos] = binding
+				p.Bindings[pos] = kept
 				break
 			}
 			if !found {
-				p.Bindings = append(p.Bindings, binding)
+				p.Bindings = append(p.Bindings, kept)
 			}
 			return nil
 		})
 		if err != nil {
 			return err
 		}
+		setLastAppliedIamPolicy(d, applied)
+		exportIamPolicy(d, updater, applied)
 
-		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+		return setIamBindingStateFromWrite(d, meta, updater, newUpdaterFunc, binding.Role, applied)
 	}
 }
 
@@ -136,8 +302,18 @@ func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.D
 			return err
 		}
 
-		binding := getResourceIamBinding(d)
-		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		binding, err := getResourceIamBinding(d, updater)
+		if err != nil {
+			return err
+		}
+		applied, err := iamPolicyReadModifyWrite(config, updater, func(p *cloudresourcemanager.Policy) error {
+			// Removing the binding from a policy which changed since it was read into state could undo changes
+			// the plan didn't account for.
+			if etag := d.Get("etag").(string); d.Get("check_etag_on_delete").(bool) && p.Etag != etag {
+				return fmt.Errorf("The IAM policy for %s changed since it was last read (etag %q, expected %q), not deleting the binding for role %q. Refresh the state and plan again.",
+					updater.DescribeResource(), p.Etag, etag, binding.Role)
+			}
+
 			toRemove := -1
 			for pos, b := range p.Bindings {
 				if b.Role != binding.Role {
@@ -150,22 +326,311 @@ func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.D
 				log.Printf("[DEBUG]: Policy bindings for %s did not include a binding for role %q", updater.DescribeResource(), binding.Role)
 				return nil
 			}
+			if kept := getSystemManagedIamMembers(p, binding.Role, getSystemManagedIamMemberPatterns(d)); len(kept) > 0 {
+				p.Bindings[toRemove] = &cloudresourcemanager.Binding{Role: binding.Role, Members: kept}
+				return nil
+			}
 
 			p.Bindings = append(p.Bindings[:toRemove], p.Bindings[toRemove+1:]...)
 			return nil
 		})
 		if err != nil {
-			return err
+			return handleIamParentNotFoundOnDelete(err, d, updater)
 		}
+		exportIamPolicy(d, updater, applied)
 
-		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+		return setIamBindingStateFromWrite(d, meta, updater, newUpdaterFunc, binding.Role, applied)
+	}
+}
+
+// resourceIamBindingImport imports the binding for the role of an ID of the form `{parent} {role}`. Given
+// the ID of the parent alone, it fails with the list of the roles of its policy to pick from.
+func resourceIamBindingImport(newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+		parts := strings.Fields(d.Id())
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("Invalid IAM binding import ID %q, expected \"{parent} {role}\"", d.Id())
+		}
+
+		d.SetId(parts[0])
+		if err := resourceIdParser(d, config); err != nil {
+			return nil, err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(parts) == 1 {
+			bindings, err := listResourceIamBindings(updater)
+			if err != nil {
+				return nil, err
+			}
+			if len(bindings) == 0 {
+				return nil, fmt.Errorf("The IAM policy for %s has no bindings to import", updater.DescribeResource())
+			}
+
+			roles := make([]string, 0, len(bindings))
+			for _, b := range bindings {
+				roles = append(roles, b.Role)
+			}
+			return nil, fmt.Errorf("Importing a binding for %s requires its role, as in \"%s {role}\". The roles of its IAM policy are: %s",
+				updater.DescribeResource(), parts[0], strings.Join(roles, ", "))
+		}
+
+		d.Set("role", parts[1])
+		d.SetId(updater.GetResourceId() + "/" + parts[1])
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// isIamBindingAuthoritativeOnCreate returns whether creating the binding replaces the members the role is
+// already granted to rather than adding to them. `authoritative_on_create` takes precedence over the default
+// of the provider when it is set.
+func isIamBindingAuthoritativeOnCreate(d *schema.ResourceData, config *Config) bool {
+	if v, ok := d.GetOkExists("authoritative_on_create"); ok {
+		return v.(bool)
+	}
+	return config.IamBindingAuthoritativeOnCreate
+}
+
+// getIamBindingMembersForState returns the members of the live binding to store in state. A deleted member
+// which the configuration lists by the name it was granted the role as is stored by that name when
+// `ignore_deleted_members` is set, so that it doesn't show as a diff, and as is with a warning otherwise.
+func getIamBindingMembersForState(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, binding *cloudresourcemanager.Binding) []string {
+	managed := d.Get("members").(*schema.Set)
+	members := make([]string, 0, len(binding.Members))
+	for _, m := range binding.Members {
+		if original, deleted := parseIamDeletedMember(m); deleted && managed.Contains(original) {
+			if d.Get("ignore_deleted_members").(bool) {
+				m = original
+			} else {
+				log.Printf("[WARN]: Member %q for binding for role %q of %s has been deleted.", logIamMember(config, original), binding.Role, updater.DescribeResource())
+			}
+		}
+		members = append(members, m)
+	}
+	return members
+}
+
+// logIamBindingMembersDrift logs a warning listing the members granted the role out of band and the
+// managed members which lost it, so that drift can be flagged at refresh time. State is still set to
+// the live members.
+func logIamBindingMembersDrift(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, role string, live []string) {
+	managed := convertStringSet(d.Get("members").(*schema.Set))
+	if len(managed) == 0 {
+		// Nothing is managed yet, e.g. while importing.
+		return
+	}
+
+	added, removed := diffIamBindingMembers(managed, live)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	for i, m := range added {
+		added[i] = logIamMember(config, m)
+	}
+	for i, m := range removed {
+		removed[i] = logIamMember(config, m)
+	}
+	log.Printf("[WARN]: Members of binding for role %q of %s drifted: added=[%s] removed=[%s]",
+		role, updater.DescribeResource(), strings.Join(added, ","), strings.Join(removed, ","))
+}
+
+// diffIamBindingMembers returns the sorted live members which aren't managed, and the sorted managed
+// members which aren't live.
+func diffIamBindingMembers(managed, live []string) (added, removed []string) {
+	isManaged := make(map[string]bool, len(managed))
+	for _, m := range managed {
+		isManaged[m] = true
+	}
+	isLive := make(map[string]bool, len(live))
+	for _, m := range live {
+		isLive[m] = true
+		if !isManaged[m] {
+			added = append(added, m)
+		}
+	}
+	for _, m := range managed {
+		if !isLive[m] {
+			removed = append(removed, m)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// getForeignIamBindingMembers returns the sorted members of the live binding for the role of binding which
+// binding doesn't list, other than those matching `system_managed_members`.
+func getForeignIamBindingMembers(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, p *cloudresourcemanager.Policy, binding *cloudresourcemanager.Binding) []string {
+	managed := schema.NewSet(schema.HashString, convertStringArrToInterface(binding.Members))
+	patterns := getSystemManagedIamMemberPatterns(d)
+	var foreign []string
+	for _, b := range p.Bindings {
+		if b.Role != binding.Role {
+			continue
+		}
+		for _, m := range getIamBindingMembersForState(d, config, updater, b) {
+			if !managed.Contains(m) && !isSystemManagedIamMember(m, patterns) {
+				foreign = append(foreign, m)
+			}
+		}
+	}
+	sort.Strings(foreign)
+	return foreign
+}
+
+// preserveSystemManagedIamMembers returns binding with the members granted its role in p which match
+// `system_managed_members` added, so that replacing the members of the binding doesn't remove them.
+func preserveSystemManagedIamMembers(d *schema.ResourceData, p *cloudresourcemanager.Policy, binding *cloudresourcemanager.Binding) *cloudresourcemanager.Binding {
+	kept := getSystemManagedIamMembers(p, binding.Role, getSystemManagedIamMemberPatterns(d))
+	if len(kept) == 0 {
+		return binding
+	}
+
+	members := schema.NewSet(schema.HashString, convertStringArrToInterface(binding.Members))
+	for _, m := range kept {
+		members.Add(m)
 	}
+	return &cloudresourcemanager.Binding{
+		Role:    binding.Role,
+		Members: convertStringArr(members.List()),
+	}
+}
+
+// excludeSystemManagedIamMembers returns the members of the live binding to store in `members`, without those
+// matching `system_managed_members` which aren't in `members`, so that they don't show as a diff.
+func excludeSystemManagedIamMembers(d *schema.ResourceData, members []string) []string {
+	patterns := getSystemManagedIamMemberPatterns(d)
+	if len(patterns) == 0 {
+		return members
+	}
+
+	managed := d.Get("members").(*schema.Set)
+	kept := make([]string, 0, len(members))
+	for _, m := range members {
+		if !isSystemManagedIamMember(m, patterns) || managed.Contains(m) {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// removeIamMembers returns the members which aren't in removed.
+func removeIamMembers(members []string, removed *schema.Set) []string {
+	kept := make([]string, 0, len(members))
+	for _, m := range members {
+		if !removed.Contains(m) {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// keepIamMembers returns the members which are in managed.
+func keepIamMembers(members []string, managed *schema.Set) []string {
+	kept := make([]string, 0, len(members))
+	for _, m := range members {
+		if managed.Contains(m) {
+			kept = append(kept, m)
+		}
+	}
+	return kept
 }
 
-func getResourceIamBinding(d *schema.ResourceData) *cloudresourcemanager.Binding {
-	members := d.Get("members").(*schema.Set).List()
+// checkIamBindingNotEmpty returns an error if the binding has no members, which is more likely the result of a
+// configuration error, e.g. an empty variable, than meant, unless `allow_empty` is set.
+func checkIamBindingNotEmpty(d *schema.ResourceData, updater ResourceIamUpdater, binding *cloudresourcemanager.Binding) error {
+	if len(binding.Members) > 0 || d.Get("allow_empty").(bool) {
+		return nil
+	}
+	return fmt.Errorf("The binding for role %q of %s has no members. Set `allow_empty` to apply an empty binding.",
+		binding.Role, updater.DescribeResource())
+}
+
+func getResourceIamBinding(d *schema.ResourceData, updater ResourceIamUpdater) (*cloudresourcemanager.Binding, error) {
+	role, err := getResourceIamRole(d, updater)
+	if err != nil {
+		return nil, err
+	}
+
+	members := schema.NewSet(schema.HashString, d.Get("members").(*schema.Set).List())
+	fileMembers, err := readIamMembersFile(d.Get("members_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range fileMembers {
+		members.Add(m)
+	}
 	return &cloudresourcemanager.Binding{
-		Members: convertStringArr(members),
-		Role:    d.Get("role").(string),
+		Members: convertStringArr(members.List()),
+		Role:    role,
+	}, nil
+}
+
+// readIamMembersFile returns the members listed in the file at path, one per line, or none if path is empty.
+// Blank lines and comments, i.e. lines starting with `#`, are ignored.
+func readIamMembersFile(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading `members_file`: %s", err)
+	}
+
+	var members []string
+	for i, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, es := validateIamMember(line, fmt.Sprintf("%s:%d", path, i+1)); len(es) > 0 {
+			return nil, es[0]
+		}
+		members = append(members, line)
+	}
+	return members, nil
+}
+
+// excludeIamMembersFile returns the members of the live binding to store in `members`, without those only listed
+// in `members_file`, so that they don't show as a diff. When some members of the file aren't granted the role,
+// e.g. as they were added to the file, `members_file` is cleared so that the diff grants them.
+func excludeIamMembersFile(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, role string, members []string) []string {
+	path := d.Get("members_file").(string)
+	if path == "" {
+		return members
+	}
+
+	fileMembers, err := readIamMembersFile(path)
+	if err != nil {
+		log.Printf("[WARN]: Couldn't read the members of binding for role %q of %s: %s", role, updater.DescribeResource(), err)
+		return members
+	}
+
+	live := make(map[string]bool, len(members))
+	for _, m := range members {
+		live[m] = true
+	}
+	fromFile := make(map[string]bool, len(fileMembers))
+	for _, m := range fileMembers {
+		fromFile[m] = true
+		if !live[m] {
+			log.Printf("[DEBUG]: Member %q of %q isn't granted role %q of %s", logIamMember(config, m), path, role, updater.DescribeResource())
+			d.Set("members_file", "")
+		}
+	}
+
+	managed := d.Get("members").(*schema.Set)
+	kept := make([]string, 0, len(members))
+	for _, m := range members {
+		if !fromFile[m] || managed.Contains(m) {
+			kept = append(kept, m)
+		}
 	}
+	return kept
 }
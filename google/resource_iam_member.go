@@ -13,31 +13,62 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 		ForceNew: true,
 	},
 	"member": {
-		Type:     schema.TypeString,
-		Required: true,
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateIamMember,
+	},
+	"expand_custom_role": {
+		Type:     schema.TypeBool,
+		Optional: true,
 		ForceNew: true,
+		Default:  false,
+	},
+	"normalize_role_case": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"ignore_deleted_members": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
 	},
 	"etag": {
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"last_applied_etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_applied_time": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
 }
 
 func ResourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIamMemberCreate(newUpdaterFunc),
 		Read:   resourceIamMemberRead(newUpdaterFunc),
+		Update: resourceIamMemberUpdate(newUpdaterFunc),
 		Delete: resourceIamMemberDelete(newUpdaterFunc),
 
 		Schema: mergeSchemas(IamMemberBaseSchema, parentSpecificSchema),
 	}
 }
 
-func getResourceIamMember(d *schema.ResourceData) *cloudresourcemanager.Binding {
+func getResourceIamMember(d *schema.ResourceData, updater ResourceIamUpdater) (*cloudresourcemanager.Binding, error) {
+	role, err := getResourceIamRole(d, updater)
+	if err != nil {
+		return nil, err
+	}
+
 	return &cloudresourcemanager.Binding{
 		Members: []string{d.Get("member").(string)},
-		Role:    d.Get("role").(string),
-	}
+		Role:    role,
+	}, nil
 }
 
 func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
@@ -48,8 +79,11 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 			return err
 		}
 
-		p := getResourceIamMember(d)
-		err = iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		p, err := getResourceIamMember(d, updater)
+		if err != nil {
+			return err
+		}
+		applied, err := iamPolicyReadModifyWrite(config, updater, func(ep *cloudresourcemanager.Policy) error {
 			// Merge the bindings together
 			ep.Bindings = mergeBindings(append(ep.Bindings, p))
 			return nil
@@ -57,8 +91,15 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 		if err != nil {
 			return err
 		}
+		setLastAppliedIamPolicy(d, applied)
 		d.SetId(updater.GetResourceId() + "/" + p.Role + "/" + p.Members[0])
-		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
+		if applied == nil {
+			return readIamAfterCreate(d, meta, resourceIamMemberRead(newUpdaterFunc))
+		}
+
+		log.Printf("[DEBUG]: Setting the state of member %q for role %q of %s from the applied policy\n", logIamMember(config, p.Members[0]), p.Role, updater.DescribeResource())
+		setIamMemberState(d, config, updater, p, applied)
+		return nil
 	}
 }
 
@@ -70,41 +111,72 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 			return err
 		}
 
-		eMember := getResourceIamMember(d)
-		p, err := updater.GetResourceIamPolicy()
+		eMember, err := getResourceIamMember(d, updater)
 		if err != nil {
 			return err
 		}
-		log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return newIamError(err)
+		}
+		log.Printf("[DEBUG]: Retrieved policy for %s (%s): %s\n", updater.DescribeResource(), updater.GetResourceDescriptor(), formatIamPolicyForLog(config, p))
 
-		var binding *cloudresourcemanager.Binding
-		for _, b := range p.Bindings {
-			if b.Role != eMember.Role {
-				continue
-			}
-			binding = b
-			break
+		setIamMemberState(d, config, updater, eMember, p)
+		if d.Id() != "" {
+			recordIamGrants(config, updater, eMember.Role, eMember.Members)
 		}
-		if binding == nil {
-			log.Printf("[DEBUG]: Binding for role %q does not exist in policy of %s, removing member %q from state.", eMember.Role, updater.DescribeResource(), eMember.Members[0])
-			d.SetId("")
-			return nil
+		return nil
+	}
+}
+
+// setIamMemberState sets the state of the member from the policy p, or removes the member from state if
+// p doesn't grant it the role.
+func setIamMemberState(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, eMember *cloudresourcemanager.Binding, p *cloudresourcemanager.Policy) {
+	var binding *cloudresourcemanager.Binding
+	for _, b := range p.Bindings {
+		if b.Role != eMember.Role {
+			continue
 		}
-		var member string
-		for _, m := range binding.Members {
-			if m == eMember.Members[0] {
-				member = m
-			}
+		binding = b
+		break
+	}
+	if binding == nil {
+		log.Printf("[DEBUG]: Binding for role %q does not exist in policy of %s, removing member %q from state.", eMember.Role, updater.DescribeResource(), logIamMember(config, eMember.Members[0]))
+		d.SetId("")
+		return
+	}
+	var member string
+	var deleted bool
+	for _, m := range binding.Members {
+		if m == eMember.Members[0] {
+			member = m
 		}
-		if member == "" {
-			log.Printf("[DEBUG]: Member %q for binding for role %q does not exist in policy of %s, removing from state.", eMember.Members[0], eMember.Role, updater.DescribeResource())
-			d.SetId("")
-			return nil
+		if original, ok := parseIamDeletedMember(m); ok && original == eMember.Members[0] {
+			deleted = true
+		}
+	}
+	if member == "" && deleted {
+		if d.Get("ignore_deleted_members").(bool) {
+			member = eMember.Members[0]
+		} else {
+			log.Printf("[WARN]: Member %q for binding for role %q of %s has been deleted.", logIamMember(config, eMember.Members[0]), eMember.Role, updater.DescribeResource())
 		}
-		d.Set("etag", p.Etag)
-		d.Set("member", member)
-		d.Set("role", binding.Role)
-		return nil
+	}
+	if member == "" {
+		log.Printf("[DEBUG]: Member %q for binding for role %q does not exist in policy of %s, removing from state.", logIamMember(config, eMember.Members[0]), eMember.Role, updater.DescribeResource())
+		d.SetId("")
+		return
+	}
+	d.Set("etag", p.Etag)
+	d.Set("member", member)
+	// The role is left as configured, as it may be the short name of a custom role.
+}
+
+// The role and member force a new resource, and ignore_deleted_members and normalize_role_case only change how
+// they're applied, so there is nothing to write.
+func resourceIamMemberUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
 	}
 }
 
@@ -116,8 +188,11 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 			return err
 		}
 
-		member := getResourceIamMember(d)
-		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		member, err := getResourceIamMember(d, updater)
+		if err != nil {
+			return err
+		}
+		_, err = iamPolicyReadModifyWrite(config, updater, func(p *cloudresourcemanager.Policy) error {
 			bindingToRemove := -1
 			for pos, b := range p.Bindings {
 				if b.Role != member.Role {
@@ -127,7 +202,7 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 				break
 			}
 			if bindingToRemove < 0 {
-				log.Printf("[DEBUG]: Binding for role %q does not exist in policy of project %q, so member %q can't be on it.", member.Role, updater.GetResourceId(), member.Members[0])
+				log.Printf("[DEBUG]: Binding for role %q does not exist in policy of project %q, so member %q can't be on it.", member.Role, updater.GetResourceId(), logIamMember(config, member.Members[0]))
 				return nil
 			}
 			binding := p.Bindings[bindingToRemove]
@@ -140,15 +215,21 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 				break
 			}
 			if memberToRemove < 0 {
-				log.Printf("[DEBUG]: Member %q for binding for role %q does not exist in policy of project %q.", member.Members[0], member.Role, updater.GetResourceId())
+				log.Printf("[DEBUG]: Member %q for binding for role %q does not exist in policy of project %q.", logIamMember(config, member.Members[0]), member.Role, updater.GetResourceId())
 				return nil
 			}
 			binding.Members = append(binding.Members[:memberToRemove], binding.Members[memberToRemove+1:]...)
+			if len(binding.Members) == 0 {
+				// Some APIs reject policies with bindings without members, so the binding of the role is removed
+				// along with its last member.
+				p.Bindings = append(p.Bindings[:bindingToRemove], p.Bindings[bindingToRemove+1:]...)
+				return nil
+			}
 			p.Bindings[bindingToRemove] = binding
 			return nil
 		})
 		if err != nil {
-			return err
+			return handleIamParentNotFoundOnDelete(err, d, updater)
 		}
 
 		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
@@ -0,0 +1,222 @@
+package google
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"log"
+	"sort"
+)
+
+var IamMemberCleanupBaseSchema = map[string]*schema.Schema{
+	"member": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateIamMember,
+	},
+	"roles": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      schema.HashString,
+	},
+	"all_roles": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"regranted_roles": {
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      schema.HashString,
+	},
+	"last_applied_etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_applied_time": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+// The parents of `google_iam_member_cleanup`, of which at most one is set. The provider project is used when
+// none is.
+var IamMemberCleanupParentSchema = map[string]*schema.Schema{
+	"project": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"folder", "org_id"},
+	},
+	"folder": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"project", "org_id"},
+	},
+	"org_id": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"project", "folder"},
+	},
+}
+
+func resourceGoogleIamMemberCleanup() *schema.Resource {
+	return ResourceIamMemberCleanup(IamMemberCleanupParentSchema, newIamMemberCleanupUpdater)
+}
+
+func newIamMemberCleanupUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	if _, ok := d.GetOk("folder"); ok {
+		return NewFolderIamUpdater(d, config)
+	}
+	if _, ok := d.GetOk("org_id"); ok {
+		return NewOrganizationIamUpdater(d, config)
+	}
+	return NewProjectIamUpdater(d, config)
+}
+
+// ResourceIamMemberCleanup returns a resource removing a member from some or all of the roles of a policy, e.g.
+// a departed user. It's authoritative only over whether the member is granted those roles: the other members
+// of the roles, and the other roles of the member, are left as they are. Deleting the resource doesn't grant
+// the roles back.
+func ResourceIamMemberCleanup(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIamMemberCleanupCreate(newUpdaterFunc),
+		Read:   resourceIamMemberCleanupRead(newUpdaterFunc),
+		Update: resourceIamMemberCleanupUpdate(newUpdaterFunc),
+		Delete: resourceIamMemberCleanupDelete(newUpdaterFunc),
+
+		Schema: mergeSchemas(IamMemberCleanupBaseSchema, parentSpecificSchema),
+	}
+}
+
+// isIamMemberCleanupRole returns whether the member is removed from role, which is either listed in `roles`, or
+// any role when `all_roles` is set.
+func isIamMemberCleanupRole(d *schema.ResourceData, role string) bool {
+	return d.Get("all_roles").(bool) || d.Get("roles").(*schema.Set).Contains(role)
+}
+
+// isIamMember returns whether m is member, or member once deleted, e.g. a departed user.
+func isIamMember(m, member string) bool {
+	original, _ := parseIamDeletedMember(m)
+	return original == member
+}
+
+func resourceIamMemberCleanupCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		member := d.Get("member").(string)
+		if !d.Get("all_roles").(bool) && d.Get("roles").(*schema.Set).Len() == 0 {
+			return fmt.Errorf("No roles to remove %q from on %s, set `roles` or `all_roles`.", member, updater.DescribeResource())
+		}
+
+		applied, err := iamPolicyReadModifyWrite(config, updater, func(p *cloudresourcemanager.Policy) error {
+			bindings := make([]*cloudresourcemanager.Binding, 0, len(p.Bindings))
+			for _, b := range p.Bindings {
+				if isIamMemberCleanupRole(d, b.Role) {
+					members := make([]string, 0, len(b.Members))
+					for _, m := range b.Members {
+						if !isIamMember(m, member) {
+							members = append(members, m)
+						}
+					}
+					if len(members) == 0 {
+						// The member was the only one granted the role.
+						continue
+					}
+					b.Members = members
+				}
+				bindings = append(bindings, b)
+			}
+			p.Bindings = bindings
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		setLastAppliedIamPolicy(d, applied)
+
+		d.SetId(updater.GetResourceId() + "/" + member)
+		if applied == nil {
+			return resourceIamMemberCleanupRead(newUpdaterFunc)(d, meta)
+		}
+		setIamMemberCleanupState(d, config, updater, applied)
+		return nil
+	}
+}
+
+// resourceIamMemberCleanupUpdate removes the member from the roles again, e.g. from those added to `roles`.
+func resourceIamMemberCleanupUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateFunc {
+	return schema.UpdateFunc(resourceIamMemberCleanupCreate(newUpdaterFunc))
+}
+
+func resourceIamMemberCleanupRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return newIamError(err)
+		}
+		log.Printf("[DEBUG]: Retrieved policy for %s (%s): %s\n", updater.DescribeResource(), updater.GetResourceDescriptor(), formatIamPolicyForLog(config, p))
+
+		setIamMemberCleanupState(d, config, updater, p)
+		return nil
+	}
+}
+
+// setIamMemberCleanupState sets `regranted_roles` to the roles the member is removed from but granted again in p,
+// e.g. outside of Terraform, and removes them from the state, so that the diff removes the member from them
+// again. With `all_roles`, `all_roles` is unset instead.
+func setIamMemberCleanupState(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, p *cloudresourcemanager.Policy) {
+	member := d.Get("member").(string)
+	granted := make(map[string]bool)
+	for _, b := range p.Bindings {
+		for _, m := range b.Members {
+			if isIamMember(m, member) && isIamMemberCleanupRole(d, b.Role) {
+				granted[b.Role] = true
+			}
+		}
+	}
+
+	d.Set("regranted_roles", sortedKeys(granted))
+	if len(granted) == 0 {
+		return
+	}
+
+	log.Printf("[WARN]: Member %q is granted roles %v of %s again", logIamMember(config, member), sortedKeys(granted), updater.DescribeResource())
+	if d.Get("all_roles").(bool) {
+		d.Set("all_roles", false)
+		return
+	}
+
+	var roles []string
+	for _, role := range convertStringSet(d.Get("roles").(*schema.Set)) {
+		if !granted[role] {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	d.Set("roles", roles)
+}
+
+func resourceIamMemberCleanupDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		// The member isn't granted its roles back, the resource is only removed from state.
+		log.Printf("[DEBUG]: Removing the cleanup of member %q from state, the policy is left as is", logIamMember(meta.(*Config), d.Get("member").(string)))
+		return nil
+	}
+}
@@ -0,0 +1,191 @@
+package google
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func newTestIamMemberCleanupUpdater() *testIamUpdater {
+	return newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:departed@example.com", "user:admin@example.com"},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:departed@example.com"},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/browser",
+		Members: []string{"deleted:user:departed@example.com?uid=123456789012345678901", "group:admins@example.com"},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/owner",
+		Members: []string{"user:departed@example.com", "user:admin@example.com"},
+	})
+}
+
+func TestIamMemberCleanup_roles(t *testing.T) {
+	u := newTestIamMemberCleanupUpdater()
+	r := ResourceIamMemberCleanup(IamProjectSchema, u.newUpdaterFunc())
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"member": "user:departed@example.com",
+		"roles":  []interface{}{"roles/viewer", "roles/editor", "roles/browser"},
+	})
+
+	if err := r.Create(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// The member is removed from the roles in a single write, and left in the others.
+	expected := map[string]map[string]bool{
+		"roles/viewer":  {"user:admin@example.com": true},
+		"roles/browser": {"group:admins@example.com": true},
+		"roles/owner":   {"user:departed@example.com": true, "user:admin@example.com": true},
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); !reflect.DeepEqual(bm, expected) {
+		t.Fatalf("Expected bindings %v, got %v", expected, bm)
+	}
+	if u.setCalls != 1 {
+		t.Fatalf("Expected the policy to be written once, got %d set calls", u.setCalls)
+	}
+	if d.Id() != "test-resource/user:departed@example.com" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+	if roles := convertStringSet(d.Get("roles").(*schema.Set)); len(roles) != 3 {
+		t.Fatalf("Expected the roles to be kept in state, got %v", roles)
+	}
+
+	// The member is granted a role again outside of Terraform, which is removed from the roles in state.
+	u.policy.Bindings = append(u.policy.Bindings, &cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:departed@example.com"},
+	})
+	if err := r.Read(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if roles := convertStringSet(d.Get("regranted_roles").(*schema.Set)); !reflect.DeepEqual(roles, []string{"roles/editor"}) {
+		t.Fatalf("Expected roles/editor to be reported as granted again, got %v", roles)
+	}
+	if roles := convertStringSet(d.Get("roles").(*schema.Set)); len(roles) != 2 {
+		t.Fatalf("Expected roles/editor to be removed from the roles in state, got %v", roles)
+	}
+
+	// The plan against the configuration updates the resource, which removes the member from it again.
+	state := applyIamMemberCleanupPlan(t, r, d, u, map[string]interface{}{
+		"member": "user:departed@example.com",
+		"roles":  []interface{}{"roles/viewer", "roles/editor", "roles/browser"},
+	})
+	if bm := rolesToMembersMap(u.policy.Bindings); bm["roles/editor"]["user:departed@example.com"] {
+		t.Fatalf("Expected the member to be removed from roles/editor again, got %v", bm)
+	}
+	if state.Attributes["roles.#"] != "3" || state.Attributes["regranted_roles.#"] != "0" {
+		t.Fatalf("Expected the configured roles and no regranted role in state, got %v", state.Attributes)
+	}
+}
+
+// applyIamMemberCleanupPlan plans the state of d against the configuration raw, checks that the plan updates the
+// resource in place, and applies it.
+func applyIamMemberCleanupPlan(t *testing.T, r *schema.Resource, d *schema.ResourceData, u *testIamUpdater, raw map[string]interface{}) *terraform.InstanceState {
+	c, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfig(c))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff.Empty() || diff.RequiresNew() {
+		t.Fatalf("Expected the resource to be updated in place, got diff %v", diff)
+	}
+
+	setCalls := u.setCalls
+	state, err := r.Apply(d.State(), diff, &Config{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if u.setCalls != setCalls+1 {
+		t.Fatalf("Expected the update to write the policy once, got %d set calls", u.setCalls-setCalls)
+	}
+	return state
+}
+
+func TestIamMemberCleanup_allRoles(t *testing.T) {
+	u := newTestIamMemberCleanupUpdater()
+	r := ResourceIamMemberCleanup(IamProjectSchema, u.newUpdaterFunc())
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"member":    "user:departed@example.com",
+		"all_roles": true,
+	})
+
+	if err := r.Create(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]map[string]bool{
+		"roles/viewer":  {"user:admin@example.com": true},
+		"roles/browser": {"group:admins@example.com": true},
+		"roles/owner":   {"user:admin@example.com": true},
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); !reflect.DeepEqual(bm, expected) {
+		t.Fatalf("Expected bindings %v, got %v", expected, bm)
+	}
+	if !d.Get("all_roles").(bool) {
+		t.Fatalf("Expected all_roles to be kept in state")
+	}
+
+	// all_roles is unset in state when the member is granted a role again.
+	u.policy.Bindings = append(u.policy.Bindings, &cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:departed@example.com"},
+	})
+	if err := r.Read(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if d.Get("all_roles").(bool) {
+		t.Fatalf("Expected all_roles to be unset in state")
+	}
+	if roles := convertStringSet(d.Get("regranted_roles").(*schema.Set)); !reflect.DeepEqual(roles, []string{"roles/editor"}) {
+		t.Fatalf("Expected roles/editor to be reported as granted again, got %v", roles)
+	}
+
+	// Deleting the resource doesn't grant the roles back.
+	if err := r.Delete(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if u.setCalls != 1 {
+		t.Fatalf("Expected the policy not to be written on read or delete, got %d set calls", u.setCalls)
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); !bm["roles/editor"]["user:departed@example.com"] {
+		t.Fatalf("Expected the member to be left in roles/editor, got %v", bm)
+	}
+
+	// The plan against the configuration removes the member from it again.
+	state := applyIamMemberCleanupPlan(t, r, d, u, map[string]interface{}{
+		"member":    "user:departed@example.com",
+		"all_roles": true,
+	})
+	if bm := rolesToMembersMap(u.policy.Bindings); bm["roles/editor"]["user:departed@example.com"] {
+		t.Fatalf("Expected the member to be removed from roles/editor again, got %v", bm)
+	}
+	if state.Attributes["all_roles"] != "true" {
+		t.Fatalf("Expected all_roles to be set in state, got %v", state.Attributes)
+	}
+}
+
+func TestIamMemberCleanup_noRoles(t *testing.T) {
+	u := newTestIamMemberCleanupUpdater()
+	r := ResourceIamMemberCleanup(IamProjectSchema, u.newUpdaterFunc())
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"member": "user:departed@example.com",
+	})
+
+	if err := r.Create(d, &Config{}); err == nil || !strings.Contains(err.Error(), "set `roles` or `all_roles`") {
+		t.Fatalf("Expected an error for the missing roles, got %v", err)
+	}
+	if u.setCalls != 0 {
+		t.Fatalf("Expected the policy not to be written, got %d set calls", u.setCalls)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"log"
+	"strings"
 )
 
 var IamPolicyBaseSchema = map[string]*schema.Schema{
@@ -19,6 +21,38 @@ var IamPolicyBaseSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"last_applied_etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_applied_time": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ignore_roles": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      schema.HashString,
+	},
+	"system_managed_members": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateIamMemberPattern,
+		},
+		Set: schema.HashString,
+	},
+	"skip_delete": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	"export_policy_to": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 }
 
 func ResourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
@@ -32,6 +66,16 @@ func ResourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdate
 	}
 }
 
+// ResourceIamPolicyWithImport returns an IAM policy resource which can be imported by the ID of its parent,
+// in the format understood by resourceIdParser. The policy_data is generated from the live policy on import.
+func ResourceIamPolicyWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc) *schema.Resource {
+	r := ResourceIamPolicy(parentSpecificSchema, newUpdaterFunc)
+	r.Importer = &schema.ResourceImporter{
+		State: ResourceIamPolicyImport(newUpdaterFunc, resourceIdParser),
+	}
+	return r
+}
+
 func ResourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
@@ -40,7 +84,7 @@ func ResourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 			return err
 		}
 
-		if err := setIamPolicyData(d, updater); err != nil {
+		if err := setIamPolicyData(d, config, updater); err != nil {
 			return err
 		}
 
@@ -59,12 +103,22 @@ func ResourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 
 		policy, err := updater.GetResourceIamPolicy()
 		if err != nil {
-			return err
+			return newIamError(err)
 		}
+		log.Printf("[DEBUG]: Retrieved policy for %s (%s): %s\n", updater.DescribeResource(), updater.GetResourceDescriptor(), formatIamPolicyForLog(config, policy))
+
+		// Bindings for ignored roles are not managed by Terraform, don't report drift on them.
+		policy.Bindings = removeIamBindingsForRoles(policy.Bindings, getIgnoredIamRoles(d))
+		policy.Bindings = removeSystemManagedIamMembers(d, policy.Bindings)
 
 		d.Set("etag", policy.Etag)
 		d.Set("policy_data", marshalIamPolicy(policy))
 
+		// The roles granted by the policy of a folder are inherited by the projects within it.
+		for _, b := range policy.Bindings {
+			recordIamGrants(config, updater, b.Role, b.Members)
+		}
+
 		return nil
 	}
 }
@@ -78,7 +132,7 @@ func ResourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Up
 		}
 
 		if d.HasChange("policy_data") {
-			if err := setIamPolicyData(d, updater); err != nil {
+			if err := setIamPolicyData(d, config, updater); err != nil {
 				return err
 			}
 		}
@@ -95,33 +149,165 @@ func ResourceIamPolicyDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 			return err
 		}
 
+		// The policy is left as is, e.g. for binding resources to take over its roles.
+		if d.Get("skip_delete").(bool) {
+			log.Printf("[DEBUG]: skip_delete is set, leaving the policy of %s as is", updater.DescribeResource())
+			return nil
+		}
+
 		// Set an empty policy to delete the attached policy.
-		err = updater.SetResourceIamPolicy(&cloudresourcemanager.Policy{})
+		_, err = setIamPolicyPreservingIgnoredRoles(d, config, updater, &cloudresourcemanager.Policy{})
 		if err != nil {
-			return err
+			return handleIamParentNotFoundOnDelete(err, d, updater)
 		}
 
 		return nil
 	}
 }
 
-func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error {
+func ResourceIamPolicyImport(newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+		if err := resourceIdParser(d, config); err != nil {
+			return nil, err
+		}
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return nil, newIamError(err)
+		}
+
+		d.SetId(updater.GetResourceId())
+		d.Set("etag", policy.Etag)
+		d.Set("policy_data", marshalIamPolicy(policy))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func setIamPolicyData(d *schema.ResourceData, config *Config, updater ResourceIamUpdater) error {
 	policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
 	if err != nil {
 		return fmt.Errorf("'policy_data' is not valid for %s: %s", updater.DescribeResource(), err)
 	}
 
-	err = updater.SetResourceIamPolicy(policy)
+	applied, err := setIamPolicyPreservingIgnoredRoles(d, config, updater, policy)
 	if err != nil {
 		return err
 	}
+	setLastAppliedIamPolicy(d, applied)
+	exportIamPolicy(d, updater, applied)
 
 	return nil
 }
 
+// setIamPolicyPreservingIgnoredRoles replaces the IAM policy of the resource with the given policy.
+// The live bindings for the roles listed in `ignore_roles` are kept as they are, e.g. the bindings of
+// service agents which get added back by Google when removed, and so are the live members matching
+// `system_managed_members` for the other roles. Unless the policy sets a version, the version
+// of the live policy is kept, instead of flapping between versions across applies. A version 3 policy stays
+// valid without conditional bindings, which can't be configured here. It returns the policy as applied, or nil if
+// the policy was already up to date.
+func setIamPolicyPreservingIgnoredRoles(d *schema.ResourceData, config *Config, updater ResourceIamUpdater, policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	ignored := getIgnoredIamRoles(d)
+	patterns := getSystemManagedIamMemberPatterns(d)
+	if len(ignored) == 0 && len(patterns) == 0 {
+		// Serialize with the other IAM resources writing to the same resource.
+		mutexKey := updater.GetMutexKey()
+		mutexKV.Lock(mutexKey)
+		defer mutexKV.Unlock(mutexKey)
+
+		if policy.Version == 0 {
+			live, err := updater.GetResourceIamPolicy()
+			if err != nil {
+				return nil, newIamError(err)
+			}
+			policy.Version = live.Version
+		}
+		applied, err := updater.SetResourceIamPolicy(policy)
+		return applied, newIamError(err)
+	}
+
+	return iamPolicyReadModifyWrite(config, updater, func(ep *cloudresourcemanager.Policy) error {
+		bindings := removeIamBindingsForRoles(policy.Bindings, ignored)
+		var systemManaged []*cloudresourcemanager.Binding
+		for _, b := range ep.Bindings {
+			if ignored[b.Role] {
+				bindings = append(bindings, b)
+			} else if members := getSystemManagedIamMembers(ep, b.Role, patterns); len(members) > 0 {
+				systemManaged = append(systemManaged, &cloudresourcemanager.Binding{Role: b.Role, Members: members})
+			}
+		}
+		if len(systemManaged) > 0 {
+			bindings = mergeBindings(append(bindings, systemManaged...))
+		}
+
+		ep.Bindings = bindings
+		ep.AuditConfigs = policy.AuditConfigs
+		if policy.Version != 0 {
+			ep.Version = policy.Version
+		}
+		return nil
+	})
+}
+
+func getIgnoredIamRoles(d *schema.ResourceData) map[string]bool {
+	roles := make(map[string]bool)
+	if v, ok := d.GetOk("ignore_roles"); ok {
+		for _, role := range convertStringSet(v.(*schema.Set)) {
+			roles[role] = true
+		}
+	}
+	return roles
+}
+
+// removeSystemManagedIamMembers returns the bindings without the members matching `system_managed_members`,
+// unless `policy_data` grants them the role, and without the bindings left empty by their removal.
+func removeSystemManagedIamMembers(d *schema.ResourceData, bindings []*cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
+	patterns := getSystemManagedIamMemberPatterns(d)
+	if len(patterns) == 0 {
+		return bindings
+	}
+
+	configured := make(map[string]map[string]bool)
+	if policy, err := unmarshalIamPolicy(d.Get("policy_data").(string)); err == nil {
+		configured = rolesToMembersMap(policy.Bindings)
+	}
+
+	kept := make([]*cloudresourcemanager.Binding, 0, len(bindings))
+	for _, b := range bindings {
+		members := make([]string, 0, len(b.Members))
+		for _, m := range b.Members {
+			if !isSystemManagedIamMember(m, patterns) || configured[b.Role][m] {
+				members = append(members, m)
+			}
+		}
+		if len(members) > 0 || len(b.Members) == 0 {
+			kept = append(kept, &cloudresourcemanager.Binding{Role: b.Role, Members: members})
+		}
+	}
+	return kept
+}
+
+func removeIamBindingsForRoles(bindings []*cloudresourcemanager.Binding, roles map[string]bool) []*cloudresourcemanager.Binding {
+	kept := make([]*cloudresourcemanager.Binding, 0, len(bindings))
+	for _, b := range bindings {
+		if !roles[b.Role] {
+			kept = append(kept, b)
+		}
+	}
+	return kept
+}
+
 func marshalIamPolicy(policy *cloudresourcemanager.Policy) string {
 	pdBytes, _ := json.Marshal(&cloudresourcemanager.Policy{
-		Bindings: policy.Bindings,
+		AuditConfigs: policy.AuditConfigs,
+		Bindings:     policy.Bindings,
 	})
 	return string(pdBytes)
 }
@@ -134,10 +320,24 @@ func unmarshalIamPolicy(policyData string) (*cloudresourcemanager.Policy, error)
 	return policy, nil
 }
 
+// The types of the audit logs which can be enabled by an audit log config.
+var iamAuditLogTypes = map[string]bool{"ADMIN_READ": true, "DATA_WRITE": true, "DATA_READ": true}
+
 func validateIamPolicy(i interface{}, k string) (s []string, es []error) {
-	_, err := unmarshalIamPolicy(i.(string))
+	policy, err := unmarshalIamPolicy(i.(string))
 	if err != nil {
 		es = append(es, err)
+		return
+	}
+
+	// Exempting members from the logs of a config which enables none has no effect.
+	for _, ac := range policy.AuditConfigs {
+		for _, lc := range ac.AuditLogConfigs {
+			if len(lc.ExemptedMembers) > 0 && !iamAuditLogTypes[lc.LogType] {
+				s = append(s, fmt.Sprintf("%q: the audit log config of service %q with log type %q exempts %s, which has no effect as the log type enables no logs",
+					k, ac.Service, lc.LogType, strings.Join(lc.ExemptedMembers, ", ")))
+			}
+		}
 	}
 	return
 }
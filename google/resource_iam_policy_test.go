@@ -0,0 +1,295 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestIamPolicyIgnoreRoles(t *testing.T) {
+	serviceAgent := &cloudresourcemanager.Binding{
+		Role:    "roles/container.serviceAgent",
+		Members: []string{"serviceAccount:service-123@container-engine-robot.iam.gserviceaccount.com"},
+	}
+	u := newTestIamUpdater(serviceAgent, &cloudresourcemanager.Binding{
+		Role:    "roles/viewer",
+		Members: []string{"user:foreign@example.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, IamPolicyBaseSchema, map[string]interface{}{
+		"policy_data":  `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com"]}]}`,
+		"ignore_roles": []interface{}{"roles/container.serviceAgent"},
+	})
+
+	if err := ResourceIamPolicyCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	bm := rolesToMembersMap(u.policy.Bindings)
+	if len(bm) != 2 || !bm["roles/editor"]["user:admin@example.com"] || !bm[serviceAgent.Role][serviceAgent.Members[0]] {
+		t.Fatalf("Expected the configured binding and the ignored service agent binding, got %v", bm)
+	}
+
+	expected := `{"bindings":[{"members":["user:admin@example.com"],"role":"roles/editor"}]}`
+	if v := d.Get("policy_data").(string); v != expected {
+		t.Fatalf("Expected the ignored role to be absent from state, got %s", v)
+	}
+
+	if err := ResourceIamPolicyDelete(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	bm = rolesToMembersMap(u.policy.Bindings)
+	if len(bm) != 1 || !bm[serviceAgent.Role][serviceAgent.Members[0]] {
+		t.Fatalf("Expected only the ignored service agent binding to remain after delete, got %v", bm)
+	}
+}
+
+func TestIamPolicyIgnoreRoles_unset(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/container.serviceAgent",
+		Members: []string{"serviceAccount:service-123@container-engine-robot.iam.gserviceaccount.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, IamPolicyBaseSchema, map[string]interface{}{
+		"policy_data": `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com"]}]}`,
+	})
+
+	if err := ResourceIamPolicyCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	bm := rolesToMembersMap(u.policy.Bindings)
+	if len(bm) != 1 || !bm["roles/editor"]["user:admin@example.com"] {
+		t.Fatalf("Expected the policy to be replaced by the configured one, got %v", bm)
+	}
+}
+
+func TestIamPolicySystemManagedMembers(t *testing.T) {
+	serviceAgent := "serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:foreign@example.com", serviceAgent},
+	}, &cloudresourcemanager.Binding{
+		Role:    "roles/pubsub.serviceAgent",
+		Members: []string{serviceAgent},
+	})
+
+	d := schema.TestResourceDataRaw(t, IamPolicyBaseSchema, map[string]interface{}{
+		"policy_data":            `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com"]}]}`,
+		"system_managed_members": []interface{}{"serviceAccount:service-*@*.gserviceaccount.com"},
+	})
+
+	if err := ResourceIamPolicyCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]map[string]bool{
+		"roles/editor":              {"user:admin@example.com": true, serviceAgent: true},
+		"roles/pubsub.serviceAgent": {serviceAgent: true},
+	}
+	if bm := rolesToMembersMap(u.policy.Bindings); !reflect.DeepEqual(bm, expected) {
+		t.Fatalf("Expected bindings %v, got %v", expected, bm)
+	}
+	if v, expected := d.Get("policy_data").(string), `{"bindings":[{"members":["user:admin@example.com"],"role":"roles/editor"}]}`; v != expected {
+		t.Fatalf("Expected the service agent to be absent from state, got %s", v)
+	}
+
+	// Applying the policy again doesn't remove the service agent, so nothing is written.
+	if err := setIamPolicyData(d, &Config{}, u); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if u.setCalls != 1 {
+		t.Fatalf("Expected the policy to be written once, got %d set calls", u.setCalls)
+	}
+}
+
+func TestIamPolicyUpdate_keepsVersion(t *testing.T) {
+	for _, ignoreRoles := range [][]interface{}{nil, {"roles/container.serviceAgent"}} {
+		u := newTestIamUpdater(&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:foreign@example.com"},
+		})
+		u.policy.Version = 3
+
+		d := schema.TestResourceDataRaw(t, IamPolicyBaseSchema, map[string]interface{}{
+			"policy_data":  `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com"]}]}`,
+			"ignore_roles": ignoreRoles,
+		})
+		if err := ResourceIamPolicyCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if u.policy.Version != 3 {
+			t.Fatalf("ignore_roles=%v: expected the version to be kept at 3 on create, got %d", ignoreRoles, u.policy.Version)
+		}
+
+		d.Set("policy_data", `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com","group:admins@example.com"]}]}`)
+		if err := ResourceIamPolicyUpdate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if u.policy.Version != 3 {
+			t.Fatalf("ignore_roles=%v: expected the version to be kept at 3 on update, got %d", ignoreRoles, u.policy.Version)
+		}
+	}
+
+	// A version set by the policy data is written as is.
+	u := newTestIamUpdater()
+	u.policy.Version = 3
+	d := schema.TestResourceDataRaw(t, IamPolicyBaseSchema, map[string]interface{}{
+		"policy_data": `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com"]}],"version":1}`,
+	})
+	if err := ResourceIamPolicyCreate(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if u.policy.Version != 1 {
+		t.Fatalf("Expected the configured version 1, got %d", u.policy.Version)
+	}
+}
+
+func TestIamPolicyDelete_skipDelete(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:admin@example.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, IamPolicyBaseSchema, map[string]interface{}{
+		"policy_data": `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com"]}]}`,
+		"skip_delete": true,
+	})
+	d.SetId("test-resource")
+
+	if err := ResourceIamPolicyDelete(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if u.setCalls != 0 {
+		t.Fatalf("Expected the policy not to be set, got %d set calls", u.setCalls)
+	}
+	bm := rolesToMembersMap(u.policy.Bindings)
+	if len(bm) != 1 || !bm["roles/editor"]["user:admin@example.com"] {
+		t.Fatalf("Expected the policy to be left as is, got %v", bm)
+	}
+}
+
+func TestIamPolicyDelete(t *testing.T) {
+	u := newTestIamUpdater(&cloudresourcemanager.Binding{
+		Role:    "roles/editor",
+		Members: []string{"user:admin@example.com"},
+	})
+
+	d := schema.TestResourceDataRaw(t, IamPolicyBaseSchema, map[string]interface{}{
+		"policy_data": `{"bindings":[{"role":"roles/editor","members":["user:admin@example.com"]}]}`,
+	})
+	d.SetId("test-resource")
+
+	if err := ResourceIamPolicyDelete(u.newUpdaterFunc())(d, &Config{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(u.policy.Bindings) != 0 {
+		t.Fatalf("Expected the policy to be cleared, got %v", rolesToMembersMap(u.policy.Bindings))
+	}
+}
+
+func TestIamPolicyImport(t *testing.T) {
+	u := newTestIamUpdater(
+		&cloudresourcemanager.Binding{
+			Role:    "roles/viewer",
+			Members: []string{"user:admin@example.com", "group:admins@example.com"},
+		},
+		&cloudresourcemanager.Binding{
+			Role:    "roles/editor",
+			Members: []string{"user:admin@example.com"},
+		},
+	)
+	u.policy.AuditConfigs = []*cloudresourcemanager.AuditConfig{
+		{
+			Service: "allServices",
+			AuditLogConfigs: []*cloudresourcemanager.AuditLogConfig{
+				{
+					LogType:         "DATA_READ",
+					ExemptedMembers: []string{"user:admin@example.com"},
+				},
+			},
+		},
+	}
+
+	r := ResourceIamPolicyWithImport(map[string]*schema.Schema{}, u.newUpdaterFunc(), func(d *schema.ResourceData, config *Config) error {
+		return nil
+	})
+	d := r.Data(nil)
+	d.SetId("test-resource")
+
+	imported, err := r.Importer.State(d, &Config{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("Expected a single imported resource, got %d", len(imported))
+	}
+	d = imported[0]
+
+	if d.Id() != "test-resource" {
+		t.Fatalf("Expected id %q, got %q", "test-resource", d.Id())
+	}
+	if d.Get("etag").(string) != u.policy.Etag {
+		t.Fatalf("Expected etag %q, got %q", u.policy.Etag, d.Get("etag").(string))
+	}
+
+	// The configuration of the user lists bindings and members in a different order.
+	config := `{
+  "auditConfigs": [{"service": "allServices", "auditLogConfigs": [{"logType": "DATA_READ", "exemptedMembers": ["user:admin@example.com"]}]}],
+  "bindings": [
+    {"role": "roles/editor", "members": ["user:admin@example.com"]},
+    {"role": "roles/viewer", "members": ["group:admins@example.com", "user:admin@example.com"]}
+  ]
+}`
+	state := d.Get("policy_data").(string)
+	if !jsonPolicyDiffSuppress("policy_data", state, config, d) {
+		t.Fatalf("Expected no diff between the imported policy %s and the configuration", state)
+	}
+
+	p, err := unmarshalIamPolicy(state)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(p.AuditConfigs) != 1 || p.AuditConfigs[0].AuditLogConfigs[0].ExemptedMembers[0] != "user:admin@example.com" {
+		t.Fatalf("Expected the audit configs to be imported, got %s", state)
+	}
+}
+
+func TestValidateIamPolicy_auditLogExemptions(t *testing.T) {
+	cases := map[string]struct {
+		policyData string
+		warnings   int
+	}{
+		"exemption of an enabled log type": {
+			policyData: `{"auditConfigs":[{"service":"allServices","auditLogConfigs":[{"logType":"DATA_READ","exemptedMembers":["user:admin@example.com"]}]}]}`,
+			warnings:   0,
+		},
+		"log type without exemptions": {
+			policyData: `{"auditConfigs":[{"service":"allServices","auditLogConfigs":[{"logType":"LOG_TYPE_UNSPECIFIED"}]}]}`,
+			warnings:   0,
+		},
+		"exemption of an unspecified log type": {
+			policyData: `{"auditConfigs":[{"service":"allServices","auditLogConfigs":[{"logType":"LOG_TYPE_UNSPECIFIED","exemptedMembers":["user:admin@example.com"]}]}]}`,
+			warnings:   1,
+		},
+		"exemption without log type": {
+			policyData: `{"auditConfigs":[{"service":"storage.googleapis.com","auditLogConfigs":[{"exemptedMembers":["user:admin@example.com"]},{"logType":"ADMIN_READ","exemptedMembers":["user:admin@example.com"]}]}]}`,
+			warnings:   1,
+		},
+	}
+
+	for tn, tc := range cases {
+		ws, es := validateIamPolicy(tc.policyData, "policy_data")
+		if len(es) > 0 {
+			t.Errorf("%s: unexpected errors: %v", tn, es)
+		}
+		if len(ws) != tc.warnings {
+			t.Errorf("%s: expected %d warnings, got %v", tn, tc.warnings, ws)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The workload identity pool must already exist, as it can't be managed by this provider.
+func TestAccIamWorkloadIdentityPoolIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_IAM_WORKLOAD_IDENTITY_POOL")
+	pool := os.Getenv("GOOGLE_IAM_WORKLOAD_IDENTITY_POOL")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, iamWorkloadIdentityPoolIamResource, IamWorkloadIdentityPoolSchema, config, fmt.Sprintf("projects/%s/locations/global/workloadIdentityPools/%s", getTestProjectFromEnv(), pool))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamWorkloadIdentityPoolIamBinding_basic(account, pool),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/iam.workloadIdentityUser", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccIamWorkloadIdentityPoolIamBinding_basic(account, workload_identity_pool_id string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_iam_workload_identity_pool_iam_binding" "foo" {
+  workload_identity_pool_id = "%s"
+  role                      = "roles/iam.workloadIdentityUser"
+  members                   = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, workload_identity_pool_id)
+}
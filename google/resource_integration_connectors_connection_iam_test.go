@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Integration Connectors connection must already exist, as it can't be managed by this provider.
+func TestAccIntegrationConnectorsConnectionIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_INTEGRATION_CONNECTORS_CONNECTION")
+	connection := os.Getenv("GOOGLE_INTEGRATION_CONNECTORS_CONNECTION")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, integrationConnectorsConnectionIamResource, IamIntegrationConnectorsConnectionSchema, config, fmt.Sprintf("projects/%s/locations/%s/connections/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), connection))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConnectorsConnectionIamBinding_basic(account, getTestRegionFromEnv(), connection),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/connectors.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccIntegrationConnectorsConnectionIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_integration_connectors_connection_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/connectors.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
@@ -0,0 +1,120 @@
+package google
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+func TestKmsKeyRingIamUpdaterResourceId(t *testing.T) {
+	cases := map[string]string{
+		"test-project/us-central1/test-key-ring": "projects/test-project/locations/us-central1/keyRings/test-key-ring",
+		"us-central1/test-key-ring":              "projects/default-project/locations/us-central1/keyRings/test-key-ring",
+	}
+
+	for keyRing, expected := range cases {
+		d := schema.TestResourceDataRaw(t, IamKmsKeyRingSchema, map[string]interface{}{
+			"key_ring_id": keyRing,
+		})
+		updater, err := NewKmsKeyRingIamUpdater(d, &Config{Project: "default-project"})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", keyRing, err)
+		}
+
+		if updater.GetResourceId() != expected {
+			t.Fatalf("%s: expected resource id %q, got %q", keyRing, expected, updater.GetResourceId())
+		}
+	}
+}
+
+func TestKmsKeyRingIamUpdater_conditionalBinding(t *testing.T) {
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		return testResponse(200, `{"etag":"BwVZ1Q==","bindings":[{"role":"roles/cloudkms.cryptoKeyEncrypter","members":["user:admin@example.com"],"condition":{"expression":"request.time < timestamp(\"2020-01-01T00:00:00Z\")"}}]}`), nil
+	})
+	var err error
+	config.clientKms, err = cloudkms.New(config.client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	updater := &KmsKeyRingIamUpdater{
+		resourceId: "projects/my-project/locations/us-central1/keyRings/my-key-ring",
+		Config:     config,
+	}
+	_, err = updater.GetResourceIamPolicy()
+	if err == nil || !strings.Contains(err.Error(), "conditional binding") {
+		t.Fatalf("Expected an error about the conditional binding, got %v", err)
+	}
+}
+
+func TestAccKmsKeyRingIamBinding(t *testing.T) {
+	t.Parallel()
+
+	projectId := "terraform-" + acctest.RandString(10)
+	projectOrg := getTestOrgFromEnv(t)
+	projectBillingAccount := getTestBillingAccountFromEnv(t)
+	keyRingName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return &KmsKeyRingIamUpdater{
+			resourceId: fmt.Sprintf("projects/%s/locations/us-central1/keyRings/%s", projectId, keyRingName),
+			Config:     config,
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKeyRingIamBinding_basic(projectId, projectOrg, projectBillingAccount, account, keyRingName),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/cloudkms.cryptoKeyEncrypter", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, projectId),
+				}),
+			},
+		},
+	})
+}
+
+func testAccKmsKeyRingIamBinding_basic(projectId, projectOrg, projectBillingAccount, account, keyRingName string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  name            = "%s"
+  project_id      = "%s"
+  org_id          = "%s"
+  billing_account = "%s"
+}
+
+resource "google_project_services" "acceptance" {
+  project  = "${google_project.acceptance.project_id}"
+  services = [
+    "cloudkms.googleapis.com",
+    "iam.googleapis.com",
+  ]
+}
+
+resource "google_service_account" "test-account" {
+  project      = "${google_project_services.acceptance.project}"
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_kms_key_ring" "key_ring" {
+  project  = "${google_project_services.acceptance.project}"
+  name     = "%s"
+  location = "us-central1"
+}
+
+resource "google_kms_key_ring_iam_binding" "foo" {
+  key_ring_id = "${google_kms_key_ring.key_ring.id}"
+  role        = "roles/cloudkms.cryptoKeyEncrypter"
+  members     = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, projectId, projectId, projectOrg, projectBillingAccount, account, keyRingName)
+}
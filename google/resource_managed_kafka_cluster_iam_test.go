@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Managed Kafka cluster must already exist, as it can't be managed by this provider.
+func TestAccManagedKafkaClusterIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_MANAGED_KAFKA_CLUSTER")
+	cluster := os.Getenv("GOOGLE_MANAGED_KAFKA_CLUSTER")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, managedKafkaClusterIamResource, IamManagedKafkaClusterSchema, config, fmt.Sprintf("projects/%s/locations/%s/clusters/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), cluster))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedKafkaClusterIamBinding_basic(account, getTestRegionFromEnv(), cluster),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/managedkafka.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccManagedKafkaClusterIamBinding_basic(account, location, cluster string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_managed_kafka_cluster_iam_binding" "foo" {
+  location = "%s"
+  cluster  = "%s"
+  role     = "roles/managedkafka.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, cluster)
+}
@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Network Connectivity hub must already exist, as it can't be managed by this provider.
+func TestAccNetworkConnectivityHubIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_NETWORK_CONNECTIVITY_HUB")
+	hub := os.Getenv("GOOGLE_NETWORK_CONNECTIVITY_HUB")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, networkConnectivityHubIamResource, IamNetworkConnectivityHubSchema, config, fmt.Sprintf("projects/%s/locations/global/hubs/%s", getTestProjectFromEnv(), hub))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConnectivityHubIamBinding_basic(account, hub),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/networkconnectivity.hubViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccNetworkConnectivityHubIamBinding_basic(account, hub string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_network_connectivity_hub_iam_binding" "foo" {
+  hub     = "%s"
+  role    = "roles/networkconnectivity.hubViewer"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, hub)
+}
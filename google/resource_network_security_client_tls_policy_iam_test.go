@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The client TLS policy must already exist, as it can't be managed by this provider.
+func TestAccNetworkSecurityClientTlsPolicyIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_NETWORK_SECURITY_CLIENT_TLS_POLICY")
+	policy := os.Getenv("GOOGLE_NETWORK_SECURITY_CLIENT_TLS_POLICY")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, networkSecurityClientTlsPolicyIamResource, IamNetworkSecurityClientTlsPolicySchema, config, fmt.Sprintf("projects/%s/locations/%s/clientTlsPolicies/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), policy))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSecurityClientTlsPolicyIamBinding_basic(account, getTestRegionFromEnv(), policy),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/compute.networkViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccNetworkSecurityClientTlsPolicyIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_network_security_client_tls_policy_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/compute.networkViewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Oracle Database Exadata infrastructure must already exist, as it can't be managed by this provider.
+func TestAccOracleDatabaseCloudExadataInfrastructureIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_ORACLE_DATABASE_CLOUD_EXADATA_INFRASTRUCTURE")
+	name := os.Getenv("GOOGLE_ORACLE_DATABASE_CLOUD_EXADATA_INFRASTRUCTURE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, oracleDatabaseCloudExadataInfrastructureIamResource, IamOracleDatabaseCloudExadataInfrastructureSchema, config, fmt.Sprintf("projects/%s/locations/%s/cloudExadataInfrastructures/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), name))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOracleDatabaseCloudExadataInfrastructureIamBinding_basic(account, getTestRegionFromEnv(), name),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/oracledatabase.cloudExadataInfrastructureViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccOracleDatabaseCloudExadataInfrastructureIamBinding_basic(account, location, name string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_oracle_database_cloud_exadata_infrastructure_iam_binding" "foo" {
+  location = "%s"
+  name     = "%s"
+  role     = "roles/oracledatabase.cloudExadataInfrastructureViewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, name)
+}
@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Parallelstore instance must already exist, as it can't be managed by this provider.
+func TestAccParallelstoreInstanceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_PARALLELSTORE_INSTANCE")
+	instance := os.Getenv("GOOGLE_PARALLELSTORE_INSTANCE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, parallelstoreInstanceIamResource, IamParallelstoreInstanceSchema, config, fmt.Sprintf("projects/%s/locations/%s/instances/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), instance))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelstoreInstanceIamBinding_basic(account, getTestRegionFromEnv(), instance),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/parallelstore.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccParallelstoreInstanceIamBinding_basic(account, location, instance string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_parallelstore_instance_iam_binding" "foo" {
+  location = "%s"
+  instance = "%s"
+  role     = "roles/parallelstore.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, instance)
+}
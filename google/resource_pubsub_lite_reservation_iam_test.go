@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Pub/Sub Lite reservation must already exist, as it can't be managed by this provider.
+func TestAccPubsubLiteReservationIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_PUBSUB_LITE_RESERVATION")
+	reservation := os.Getenv("GOOGLE_PUBSUB_LITE_RESERVATION")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, pubsubLiteReservationIamResource, IamPubsubLiteReservationSchema, config, fmt.Sprintf("projects/%s/locations/%s/reservations/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), reservation))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubLiteReservationIamBinding_basic(account, getTestRegionFromEnv(), reservation),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/pubsublite.subscriber", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccPubsubLiteReservationIamBinding_basic(account, region, reservation string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_pubsub_lite_reservation_iam_binding" "foo" {
+  region      = "%s"
+  reservation = "%s"
+  role        = "roles/pubsublite.subscriber"
+  members     = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, reservation)
+}
@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Pub/Sub Lite topic must already exist, as it can't be managed by this provider.
+func TestAccPubsubLiteTopicIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_PUBSUB_LITE_TOPIC")
+	topic := os.Getenv("GOOGLE_PUBSUB_LITE_TOPIC")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, pubsubLiteTopicIamResource, IamPubsubLiteTopicSchema, config, fmt.Sprintf("projects/%s/locations/%s/topics/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), topic))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubLiteTopicIamBinding_basic(account, getTestRegionFromEnv(), topic),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/pubsublite.publisher", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccPubsubLiteTopicIamBinding_basic(account, region, topic string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_pubsub_lite_topic_iam_binding" "foo" {
+  region  = "%s"
+  topic   = "%s"
+  role    = "roles/pubsublite.publisher"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, topic)
+}
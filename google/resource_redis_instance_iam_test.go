@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Redis instance must already exist, as it can't be managed by this provider.
+func TestAccRedisInstanceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_REDIS_INSTANCE")
+	instance := os.Getenv("GOOGLE_REDIS_INSTANCE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, redisInstanceIamResource, IamRedisInstanceSchema, config, fmt.Sprintf("projects/%s/locations/%s/instances/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), instance))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisInstanceIamBinding_basic(account, getTestRegionFromEnv(), instance),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/redis.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccRedisInstanceIamBinding_basic(account, region, instance string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_redis_instance_iam_binding" "foo" {
+  region   = "%s"
+  instance = "%s"
+  role     = "roles/redis.viewer"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, instance)
+}
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The source must already exist in the test organization, as it can't be managed by this provider.
+func TestAccSecurityCenterV2FindingSourceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_SCC_SOURCE")
+	source := os.Getenv("GOOGLE_SCC_SOURCE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, securityCenterV2FindingSourceIamResource, IamSecurityCenterV2FindingSourceSchema, config, fmt.Sprintf("organizations/%s/locations/global/sources/%s", getTestOrgFromEnv(t), source))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityCenterV2FindingSourceIamBinding_basic(account, getTestOrgFromEnv(t), source),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/securitycenter.findingsViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccSecurityCenterV2FindingSourceIamBinding_basic(account, organization, source string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_scc_v2_organization_source_iam_binding" "foo" {
+  organization = "%s"
+  source       = "%s"
+  role         = "roles/securitycenter.findingsViewer"
+  members      = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, organization, source)
+}
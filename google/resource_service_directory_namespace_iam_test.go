@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Service Directory namespace must already exist, as it can't be managed by this provider.
+func TestAccServiceDirectoryNamespaceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_SERVICE_DIRECTORY_NAMESPACE")
+	namespace := os.Getenv("GOOGLE_SERVICE_DIRECTORY_NAMESPACE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, serviceDirectoryNamespaceIamResource, IamServiceDirectoryNamespaceSchema, config, fmt.Sprintf("projects/%s/locations/%s/namespaces/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), namespace))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDirectoryNamespaceIamBinding_basic(account, getTestRegionFromEnv(), namespace),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/servicedirectory.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccServiceDirectoryNamespaceIamBinding_basic(account, location, namespace string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_service_directory_namespace_iam_binding" "foo" {
+  location  = "%s"
+  namespace = "%s"
+  role      = "roles/servicedirectory.viewer"
+  members   = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, namespace)
+}
@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Vertex AI index endpoint must already exist, as it can't be managed by this provider.
+func TestAccVertexAIIndexEndpointIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_VERTEX_AI_INDEX_ENDPOINT")
+	indexEndpoint := os.Getenv("GOOGLE_VERTEX_AI_INDEX_ENDPOINT")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, vertexAIIndexEndpointIamResource, IamVertexAIIndexEndpointSchema, config, fmt.Sprintf("projects/%s/locations/%s/indexEndpoints/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), indexEndpoint))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIIndexEndpointIamBinding_basic(account, getTestRegionFromEnv(), indexEndpoint),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/aiplatform.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccVertexAIIndexEndpointIamBinding_basic(account, region, indexEndpoint string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_vertex_ai_index_endpoint_iam_binding" "foo" {
+  region         = "%s"
+  index_endpoint = "%s"
+  role           = "roles/aiplatform.viewer"
+  members        = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, indexEndpoint)
+}
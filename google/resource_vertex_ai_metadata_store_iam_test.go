@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Vertex AI metadata store must already exist, as it can't be managed by this provider.
+func TestAccVertexAIMetadataStoreIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_VERTEX_AI_METADATA_STORE")
+	metadataStore := os.Getenv("GOOGLE_VERTEX_AI_METADATA_STORE")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, vertexAIMetadataStoreIamResource, IamVertexAIMetadataStoreSchema, config, fmt.Sprintf("projects/%s/locations/%s/metadataStores/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), metadataStore))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAIMetadataStoreIamBinding_basic(account, getTestRegionFromEnv(), metadataStore),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/aiplatform.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccVertexAIMetadataStoreIamBinding_basic(account, region, metadata_store string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_vertex_ai_metadata_store_iam_binding" "foo" {
+  region         = "%s"
+  metadata_store = "%s"
+  role           = "roles/aiplatform.viewer"
+  members        = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, metadata_store)
+}
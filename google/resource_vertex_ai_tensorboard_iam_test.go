@@ -0,0 +1,53 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Vertex AI Tensorboard must already exist, as it can't be managed by this provider.
+func TestAccVertexAITensorboardIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_VERTEX_AI_TENSORBOARD")
+	tensorboard := os.Getenv("GOOGLE_VERTEX_AI_TENSORBOARD")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, vertexAITensorboardIamResource, IamVertexAITensorboardSchema, config, fmt.Sprintf("projects/%s/locations/%s/tensorboards/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), tensorboard))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVertexAITensorboardIamBinding_basic(account, getTestRegionFromEnv(), tensorboard),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/aiplatform.viewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccVertexAITensorboardIamBinding_basic(account, region, tensorboard string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_vertex_ai_tensorboard_iam_binding" "foo" {
+  region      = "%s"
+  tensorboard = "%s"
+  role        = "roles/aiplatform.viewer"
+  members     = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, region, tensorboard)
+}
@@ -0,0 +1,55 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The VMware Engine private cloud must already exist, as it can't be managed by this provider. Private clouds are
+// zonal, so their location is given along with their name.
+func TestAccVmwareenginePrivateCloudIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_VMWAREENGINE_LOCATION", "GOOGLE_VMWAREENGINE_PRIVATE_CLOUD")
+	location := os.Getenv("GOOGLE_VMWAREENGINE_LOCATION")
+	privateCloud := os.Getenv("GOOGLE_VMWAREENGINE_PRIVATE_CLOUD")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, vmwareenginePrivateCloudIamResource, IamVmwareenginePrivateCloudSchema, config, fmt.Sprintf("projects/%s/locations/%s/privateClouds/%s", getTestProjectFromEnv(), location, privateCloud))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccSkipIfIamUnsupported(t, newUpdater)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmwareenginePrivateCloudIamBinding_basic(account, location, privateCloud),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/vmwareengine.vmwareengineViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccVmwareenginePrivateCloudIamBinding_basic(account, location, privateCloud string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_vmwareengine_private_cloud_iam_binding" "foo" {
+  location      = "%s"
+  private_cloud = "%s"
+  role          = "roles/vmwareengine.vmwareengineViewer"
+  members       = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, privateCloud)
+}
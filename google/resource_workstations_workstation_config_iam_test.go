@@ -0,0 +1,52 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The workstation cluster and configuration must already exist, as they can't be managed by this provider.
+func TestAccWorkstationsWorkstationConfigIamBinding(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvNotSet(t, "GOOGLE_WORKSTATIONS_CLUSTER", "GOOGLE_WORKSTATIONS_WORKSTATION_CONFIG")
+	cluster := os.Getenv("GOOGLE_WORKSTATIONS_CLUSTER")
+	workstationConfig := os.Getenv("GOOGLE_WORKSTATIONS_WORKSTATION_CONFIG")
+	account := acctest.RandomWithPrefix("tf-test")
+	newUpdater := func(config *Config) ResourceIamUpdater {
+		return testGenericIamUpdater(t, workstationsWorkstationConfigIamResource, IamWorkstationsWorkstationConfigSchema, config, fmt.Sprintf("projects/%s/locations/%s/workstationClusters/%s/workstationConfigs/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), cluster, workstationConfig))
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstationConfigIamBinding_basic(account, getTestRegionFromEnv(), cluster, workstationConfig),
+				Check: testAccCheckIamBindingMembers(newUpdater, "roles/workstations.user", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func testAccWorkstationsWorkstationConfigIamBinding_basic(account, location, cluster, workstationConfig string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_workstations_workstation_config_iam_binding" "foo" {
+  location = "%s"
+  cluster  = "%s"
+  config   = "%s"
+  role     = "roles/workstations.user"
+  members  = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, account, location, cluster, workstationConfig)
+}
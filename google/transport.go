@@ -0,0 +1,81 @@
+package google
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+)
+
+// sendRequest sends a JSON request to a Google API for which no client library is vendored
+// and decodes the JSON response into out, which may be nil if the response is not needed.
+//
+// Errors returned by the API are returned as *googleapi.Error.
+func sendRequest(config *Config, method, rawurl string, body, out interface{}) error {
+	var buf io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		buf = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, rawurl, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", config.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := config.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer googleapi.CloseBody(res)
+
+	if err := googleapi.CheckResponse(res); err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// iamUserAgentTransport appends a suffix to the user agent of the requests getting and setting IAM
+// policies, whether they're sent by a client library or by sendRequest.
+type iamUserAgentTransport struct {
+	suffix string
+	base   http.RoundTripper
+}
+
+func (t *iamUserAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIamPolicyRequest(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the request it's given.
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header))
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	r.Header.Set("User-Agent", strings.TrimSpace(req.Header.Get("User-Agent")+" "+t.suffix))
+
+	return t.base.RoundTrip(r)
+}
+
+// Most APIs expose the IAM methods as custom methods (`:getIamPolicy`), compute as sub-resources
+// (`/getIamPolicy`).
+func isIamPolicyRequest(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "getIamPolicy") || strings.HasSuffix(req.URL.Path, "setIamPolicy")
+}
@@ -0,0 +1,118 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// testRoundTripper serves the requests of an http.Client in unit tests.
+type testRoundTripper func(req *http.Request) (*http.Response, error)
+
+func (f testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testResponse(code int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func testConfigWithTransport(f testRoundTripper) *Config {
+	return &Config{
+		Project:   "my-project",
+		client:    &http.Client{Transport: f},
+		userAgent: "test-agent",
+	}
+}
+
+func TestSendRequest(t *testing.T) {
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("User-Agent") != "test-agent" {
+			t.Errorf("Expected user agent %q, got %q", "test-agent", req.Header.Get("User-Agent"))
+		}
+		if req.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected a JSON request, got %q", req.Header.Get("Content-Type"))
+		}
+		b, _ := ioutil.ReadAll(req.Body)
+		if string(b) != `{"name":"foo"}` {
+			t.Errorf("Unexpected request body %s", b)
+		}
+		return testResponse(200, `{"name":"bar"}`), nil
+	})
+
+	out := map[string]string{}
+	if err := sendRequest(config, "POST", "https://example.googleapis.com/v1/foo", map[string]string{"name": "foo"}, &out); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if out["name"] != "bar" {
+		t.Fatalf("Expected the response to be decoded, got %v", out)
+	}
+}
+
+func TestSendRequest_apiError(t *testing.T) {
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		return testResponse(409, `{"error":{"code":409,"message":"There were concurrent policy changes."}}`), nil
+	})
+
+	err := sendRequest(config, "GET", "https://example.googleapis.com/v1/foo", nil, nil)
+	gerr, ok := err.(*googleapi.Error)
+	if !ok {
+		t.Fatalf("Expected a *googleapi.Error, got %#v", err)
+	}
+	if gerr.Code != 409 {
+		t.Fatalf("Expected code 409, got %d", gerr.Code)
+	}
+	if !isConflictError(err) {
+		t.Fatalf("Expected a conflict error")
+	}
+}
+
+func TestIamUserAgentTransport(t *testing.T) {
+	var userAgents []string
+	config := testConfigWithTransport(func(req *http.Request) (*http.Response, error) {
+		userAgents = append(userAgents, req.URL.Path+" "+req.Header.Get("User-Agent"))
+		return testResponse(200, `{}`), nil
+	})
+	config.client.Transport = &iamUserAgentTransport{suffix: "my-team/1.0", base: config.client.Transport}
+
+	if _, err := getRestIamPolicy(config, "GET", "https://example.googleapis.com/v1/foo"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := setComputeRestIamPolicy(config, "https://www.googleapis.com/compute/v1/projects/my-project/global/snapshots/foo", &cloudresourcemanager.Policy{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := sendRequest(config, "GET", "https://example.googleapis.com/v1/foo", nil, nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	crm, err := cloudresourcemanager.New(config.client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	crm.UserAgent = config.userAgent
+	if _, err := crm.Projects.GetIamPolicy("my-project", &cloudresourcemanager.GetIamPolicyRequest{}).Do(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"/v1/foo:getIamPolicy test-agent my-team/1.0",
+		"/compute/v1/projects/my-project/global/snapshots/foo/setIamPolicy test-agent my-team/1.0",
+		"/v1/foo test-agent",
+	}
+	if fmt.Sprint(userAgents[:3]) != fmt.Sprint(expected) {
+		t.Fatalf("Expected user agents %v, got %v", expected, userAgents)
+	}
+	if ua := userAgents[3]; !strings.HasPrefix(ua, "/v1/projects/my-project:getIamPolicy ") || !strings.HasSuffix(ua, " my-team/1.0") {
+		t.Fatalf("Expected the client library request to have the suffix, got %q", ua)
+	}
+}
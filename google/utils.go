@@ -245,6 +245,28 @@ func isConflictError(err error) bool {
 	return false
 }
 
+// isGoogleApiErrorWithCode returns whether err is, or wraps, a *googleapi.Error with one of the given HTTP codes.
+func isGoogleApiErrorWithCode(err error, codes ...int) bool {
+	if err == nil {
+		return false
+	}
+
+	gerr, ok := err.(*googleapi.Error)
+	if !ok {
+		if !errwrap.ContainsType(err, &googleapi.Error{}) {
+			return false
+		}
+		gerr = errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	}
+
+	for _, code := range codes {
+		if gerr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
 func linkDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	parts := strings.Split(old, "/")
 	if parts[len(parts)-1] == new {